@@ -0,0 +1,191 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Kind int
+
+const (
+	KindUnit Kind = iota
+	KindBool
+	// Unsigned integers, the bit size is Type.Bits
+	KindUint
+	KindB256
+	// Dynamic string slice
+	KindStr
+	// Fixed size string, the size is Type.Len
+	KindStrArray
+	// Fixed size array, the size is Type.Len and the element type is Type.Elem
+	KindArray
+	KindTuple
+	KindStruct
+	KindEnum
+	// std::option::Option, decoded as nil or the value of Some
+	KindOption
+	// std::vec::Vec, the element type is Type.Elem
+	KindVec
+	// std::bytes::Bytes
+	KindBytes
+	// std::string::String
+	KindString
+	KindRawSlice
+	KindRawPtr
+)
+
+// Field is a field of a struct, an element of a tuple or a variant of an enum
+type Field struct {
+	Name string
+	Type *Type
+}
+
+// Type is a type in the ABI with all the generic parameters resolved
+type Type struct {
+	Kind Kind
+	// Full path of the struct or enum, such as "std::option::Option"
+	Name   string
+	Bits   int
+	Len    int
+	Elem   *Type
+	Fields []Field
+}
+
+// typeRef references a type by metadataTypeId (typeId in the legacy format) if it is a number,
+// or by concreteTypeId if it is a string
+type typeRef struct {
+	metadata *int
+	concrete string
+}
+
+func (r *typeRef) UnmarshalJSON(raw []byte) error {
+	if bytes.HasPrefix(raw, []byte{'"'}) {
+		return json.Unmarshal(raw, &r.concrete)
+	}
+	return json.Unmarshal(raw, &r.metadata)
+}
+
+type component struct {
+	Name string `json:"name"`
+	// Used by the legacy format
+	Type          *typeRef    `json:"type"`
+	TypeId        *typeRef    `json:"typeId"`
+	TypeArguments []component `json:"typeArguments"`
+}
+
+func (c component) ref() (typeRef, error) {
+	ref := c.TypeId
+	if ref == nil {
+		ref = c.Type
+	}
+	if ref == nil || (ref.metadata == nil && ref.concrete == "") {
+		return typeRef{}, fmt.Errorf("component %q has no type", c.Name)
+	}
+	return *ref, nil
+}
+
+type metadataType struct {
+	Type           string      `json:"type"`
+	MetadataTypeId *int        `json:"metadataTypeId"`
+	TypeId         *int        `json:"typeId"`
+	Components     []component `json:"components"`
+	TypeParameters []int       `json:"typeParameters"`
+}
+
+type concreteType struct {
+	Type           string   `json:"type"`
+	ConcreteTypeId string   `json:"concreteTypeId"`
+	MetadataTypeId *int     `json:"metadataTypeId"`
+	TypeArguments  []string `json:"typeArguments"`
+}
+
+// logId is a decimal string in the current format and may be a number in the legacy format
+type logId uint64
+
+func (l *logId) UnmarshalJSON(raw []byte) error {
+	s := strings.Trim(string(raw), `"`)
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid log id %s: %w", string(raw), err)
+	}
+	*l = logId(id)
+	return nil
+}
+
+type loggedType struct {
+	LogId          logId  `json:"logId"`
+	ConcreteTypeId string `json:"concreteTypeId"`
+	// Used by the legacy format
+	LoggedType *component `json:"loggedType"`
+}
+
+type abiJSON struct {
+	ProgramType     string         `json:"programType"`
+	SpecVersion     string         `json:"specVersion"`
+	EncodingVersion string         `json:"encodingVersion"`
+	Encoding        string         `json:"encoding"`
+	ConcreteTypes   []concreteType `json:"concreteTypes"`
+	MetadataTypes   []metadataType `json:"metadataTypes"`
+	Types           []metadataType `json:"types"`
+	LoggedTypes     []loggedType   `json:"loggedTypes"`
+}
+
+// ABI is the parsed Sway ABI JSON of a program
+type ABI struct {
+	ProgramType string
+	logs        map[uint64]*Type
+}
+
+// Parse parses the ABI JSON generated by forc, both the current format with concreteTypes and metadataTypes
+// and the legacy format with types are supported, but only the encoding version 1 can be decoded
+func Parse(raw []byte) (*ABI, error) {
+	var doc abiJSON
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("parse abi json failed: %w", err)
+	}
+	encoding := doc.EncodingVersion
+	if encoding == "" {
+		encoding = doc.Encoding
+	}
+	if encoding != "1" {
+		return nil, fmt.Errorf("unsupported encoding version %q", encoding)
+	}
+	r := &resolver{
+		metadata: make(map[int]*metadataType),
+		concrete: make(map[string]*concreteType),
+		cache:    make(map[string]*Type),
+	}
+	metadataTypes := append(doc.MetadataTypes, doc.Types...)
+	for i := range metadataTypes {
+		mt := &metadataTypes[i]
+		id := mt.MetadataTypeId
+		if id == nil {
+			id = mt.TypeId
+		}
+		if id == nil {
+			return nil, fmt.Errorf("metadata type %d %q has no id", i, mt.Type)
+		}
+		r.metadata[*id] = mt
+	}
+	for i := range doc.ConcreteTypes {
+		r.concrete[doc.ConcreteTypes[i].ConcreteTypeId] = &doc.ConcreteTypes[i]
+	}
+	a := &ABI{ProgramType: doc.ProgramType, logs: make(map[uint64]*Type)}
+	for _, lt := range doc.LoggedTypes {
+		var typ *Type
+		var err error
+		if lt.LoggedType != nil {
+			typ, err = r.component(*lt.LoggedType, nil)
+		} else {
+			typ, err = r.concreteType(lt.ConcreteTypeId)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("resolve type of log %d failed: %w", lt.LogId, err)
+		}
+		a.logs[uint64(lt.LogId)] = typ
+	}
+	return a, nil
+}
@@ -0,0 +1,211 @@
+package abi
+
+import (
+	"encoding/binary"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+const testABI = `{
+  "programType": "contract",
+  "specVersion": "1",
+  "encodingVersion": "1",
+  "concreteTypes": [
+    {"type": "()", "concreteTypeId": "unit"},
+    {"type": "bool", "concreteTypeId": "bool"},
+    {"type": "u8", "concreteTypeId": "u8"},
+    {"type": "u16", "concreteTypeId": "u16"},
+    {"type": "u32", "concreteTypeId": "u32"},
+    {"type": "u64", "concreteTypeId": "u64"},
+    {"type": "u256", "concreteTypeId": "u256"},
+    {"type": "b256", "concreteTypeId": "b256"},
+    {"type": "str", "concreteTypeId": "str"},
+    {"type": "str[3]", "concreteTypeId": "str3"},
+    {"type": "raw untyped ptr", "concreteTypeId": "ptr"},
+    {"type": "struct Event", "concreteTypeId": "event", "metadataTypeId": 4},
+    {"type": "enum std::option::Option<u64>", "concreteTypeId": "option_u64", "metadataTypeId": 3, "typeArguments": ["u64"]}
+  ],
+  "metadataTypes": [
+    {"type": "(_, _)", "metadataTypeId": 0, "components": [
+      {"name": "__tuple_element", "typeId": "u64"},
+      {"name": "__tuple_element", "typeId": "bool"}
+    ]},
+    {"type": "[_; 2]", "metadataTypeId": 1, "components": [{"name": "__array_element", "typeId": "u8"}]},
+    {"type": "enum MyEnum", "metadataTypeId": 2, "components": [
+      {"name": "A", "typeId": "unit"},
+      {"name": "B", "typeId": 7}
+    ], "typeParameters": [7]},
+    {"type": "enum std::option::Option", "metadataTypeId": 3, "components": [
+      {"name": "None", "typeId": "unit"},
+      {"name": "Some", "typeId": 7}
+    ], "typeParameters": [7]},
+    {"type": "struct Event", "metadataTypeId": 4, "components": [
+      {"name": "id", "typeId": "b256"},
+      {"name": "amount", "typeId": "u256"},
+      {"name": "tags", "typeId": 5, "typeArguments": [{"name": "", "typeId": "u64"}]},
+      {"name": "name", "typeId": 8},
+      {"name": "data", "typeId": 9},
+      {"name": "code", "typeId": "str3"},
+      {"name": "pair", "typeId": 0},
+      {"name": "arr", "typeId": 1},
+      {"name": "maybe", "typeId": 3, "typeArguments": [{"name": "", "typeId": "u32"}]},
+      {"name": "kind", "typeId": 2, "typeArguments": [
+        {"name": "", "typeId": 6, "typeArguments": [{"name": "", "typeId": "u16"}]}
+      ]},
+      {"name": "label", "typeId": "str"}
+    ]},
+    {"type": "struct std::vec::Vec", "metadataTypeId": 5, "components": [
+      {"name": "buf", "typeId": 10, "typeArguments": [{"name": "", "typeId": 7}]},
+      {"name": "len", "typeId": "u64"}
+    ], "typeParameters": [7]},
+    {"type": "struct Wrapper", "metadataTypeId": 6, "components": [{"name": "inner", "typeId": 11}], "typeParameters": [11]},
+    {"type": "generic T", "metadataTypeId": 7},
+    {"type": "struct std::string::String", "metadataTypeId": 8, "components": [{"name": "bytes", "typeId": 9}]},
+    {"type": "struct std::bytes::Bytes", "metadataTypeId": 9, "components": [
+      {"name": "buf", "typeId": 12},
+      {"name": "len", "typeId": "u64"}
+    ]},
+    {"type": "struct std::vec::RawVec", "metadataTypeId": 10, "components": [
+      {"name": "ptr", "typeId": "ptr"},
+      {"name": "cap", "typeId": "u64"}
+    ], "typeParameters": [7]},
+    {"type": "generic V", "metadataTypeId": 11},
+    {"type": "struct std::bytes::RawBytes", "metadataTypeId": 12, "components": [
+      {"name": "ptr", "typeId": "ptr"},
+      {"name": "cap", "typeId": "u64"}
+    ]}
+  ],
+  "functions": [],
+  "loggedTypes": [
+    {"logId": "100", "concreteTypeId": "event"},
+    {"logId": "200", "concreteTypeId": "option_u64"}
+  ],
+  "messagesTypes": [],
+  "configurables": []
+}`
+
+type encoder []byte
+
+func (e encoder) u64(v uint64) encoder {
+	return binary.BigEndian.AppendUint64(e, v)
+}
+
+func (e encoder) raw(b ...byte) encoder {
+	return append(e, b...)
+}
+
+func (e encoder) dynamic(b []byte) encoder {
+	return e.u64(uint64(len(b))).raw(b...)
+}
+
+func Test_DecodeLog(t *testing.T) {
+	a, err := Parse([]byte(testABI))
+	assert.NoError(t, err)
+	assert.Equal(t, "contract", a.ProgramType)
+
+	id := common.HexToHash("0x1111111111111111111111111111111111111111111111111111111111111111")
+	amount := common.BigToHash(big.NewInt(1000))
+	data := encoder{}.
+		raw(id.Bytes()...).
+		raw(amount.Bytes()...).
+		u64(2).u64(5).u64(6).
+		dynamic([]byte("hi")).
+		dynamic([]byte{1, 2, 3}).
+		raw([]byte("abc")...).
+		u64(7).raw(1).
+		raw(9, 10).
+		u64(1).raw(binary.BigEndian.AppendUint32(nil, 42)...).
+		u64(1).raw(binary.BigEndian.AppendUint16(nil, 300)...).
+		dynamic([]byte("xyz"))
+
+	value, err := a.DecodeLogData(types.LogDataReceipt{Rb: 100, Data: types.HexString{Bytes: []byte(data)}})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"id":     id,
+		"amount": big.NewInt(1000),
+		"tags":   []any{uint64(5), uint64(6)},
+		"name":   "hi",
+		"data":   []byte{1, 2, 3},
+		"code":   "abc",
+		"pair":   []any{uint64(7), true},
+		"arr":    []any{uint8(9), uint8(10)},
+		"maybe":  uint32(42),
+		"kind":   map[string]any{"B": map[string]any{"inner": uint16(300)}},
+		"label":  "xyz",
+	}, value)
+
+	value, err = a.DecodeLog(200, encoder{}.u64(0))
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	value, err = a.DecodeLog(200, encoder{}.u64(1).u64(3))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), value)
+
+	typ, has := a.LogType(200)
+	assert.True(t, has)
+	assert.Equal(t, KindOption, typ.Kind)
+	assert.Equal(t, "std::option::Option", typ.Name)
+}
+
+func Test_DecodeLogMalformed(t *testing.T) {
+	a, err := Parse([]byte(testABI))
+	assert.NoError(t, err)
+
+	_, err = a.DecodeLog(300, nil)
+	assert.EqualError(t, err, "log id 300 not found in the abi")
+
+	_, err = a.DecodeLog(200, encoder{}.u64(2))
+	assert.EqualError(t, err, "invalid variant 2 of std::option::Option")
+
+	_, err = a.DecodeLog(200, encoder{}.u64(1).raw(1, 2))
+	assert.EqualError(t, err, "variant std::option::Option::Some: need 8 bytes at offset 8 but only 2 left")
+
+	_, err = a.DecodeLog(200, encoder{}.u64(0).raw(1))
+	assert.EqualError(t, err, "1 bytes left after decoding")
+
+	_, err = a.DecodeLog(100, encoder{}.raw(make([]byte, 64)...).u64(1<<60))
+	assert.EqualError(t, err, "field Event.tags: 1152921504606846976 elements need at least 8 bytes each at offset 72 but only 0 left")
+}
+
+func Test_DecodeZeroSizeElements(t *testing.T) {
+	unit := &Type{Kind: KindUnit}
+	vec := &Type{Kind: KindVec, Name: "std::vec::Vec", Elem: &Type{Kind: KindTuple, Fields: []Field{{Type: unit}}}}
+
+	value, err := Decode(vec, encoder{}.u64(3))
+	assert.NoError(t, err)
+	assert.Equal(t, []any{[]any{nil}, []any{nil}, []any{nil}}, value)
+
+	_, err = Decode(vec, encoder{}.u64(1<<60))
+	assert.EqualError(t, err, "1152921504606846976 zero size elements exceed the limit 65536")
+	_, err = Decode(&Type{Kind: KindVec, Elem: unit}, encoder{}.u64(maxZeroSizeElements+1))
+	assert.EqualError(t, err, "65537 zero size elements exceed the limit 65536")
+}
+
+func Test_ParseLegacy(t *testing.T) {
+	a, err := Parse([]byte(`{
+  "encoding": "1",
+  "types": [
+    {"typeId": 0, "type": "u64", "components": null, "typeParameters": null},
+    {"typeId": 1, "type": "struct Foo", "components": [
+      {"name": "a", "type": 0, "typeArguments": null},
+      {"name": "v", "type": 2, "typeArguments": [{"name": "", "type": 0, "typeArguments": null}]}
+    ], "typeParameters": null},
+    {"typeId": 2, "type": "struct Vec", "components": [], "typeParameters": [3]},
+    {"typeId": 3, "type": "generic T", "components": null, "typeParameters": null}
+  ],
+  "loggedTypes": [{"logId": 0, "loggedType": {"name": "", "type": 1, "typeArguments": []}}]
+}`))
+	assert.NoError(t, err)
+	value, err := a.DecodeLog(0, encoder{}.u64(1).u64(1).u64(2))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": uint64(1), "v": []any{uint64(2)}}, value)
+
+	_, err = Parse([]byte(`{"types": []}`))
+	assert.EqualError(t, err, `unsupported encoding version ""`)
+
+	_, err = Parse([]byte(`{"encodingVersion": "1", "loggedTypes": [{"logId": "1", "concreteTypeId": "x"}]}`))
+	assert.EqualError(t, err, "resolve type of log 1 failed: concrete type x not found")
+}
@@ -0,0 +1,241 @@
+package abi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sentioxyz/fuel-go/types"
+	"math/big"
+)
+
+// LogType returns the type of the log with the log id, which is the Rb of the LOG_DATA receipt
+func (a *ABI) LogType(logId uint64) (*Type, bool) {
+	typ, has := a.logs[logId]
+	return typ, has
+}
+
+// DecodeLog decodes the data of the log with the log id, see Decode for the decoded values
+func (a *ABI) DecodeLog(logId uint64, data []byte) (any, error) {
+	typ, has := a.logs[logId]
+	if !has {
+		return nil, fmt.Errorf("log id %d not found in the abi", logId)
+	}
+	return Decode(typ, data)
+}
+
+// DecodeLogData decodes the data of the LOG_DATA receipt
+func (a *ABI) DecodeLogData(receipt types.LogDataReceipt) (any, error) {
+	return a.DecodeLog(uint64(receipt.Rb), receipt.Data.Bytes)
+}
+
+// Decode decodes the data encoded by the encoding version 1, all the data should be consumed.
+// The values are decoded as:
+//
+//	()                         nil
+//	bool                       bool
+//	u8, u16, u32, u64          uint8, uint16, uint32, uint64
+//	u256                       *big.Int
+//	b256                       common.Hash
+//	str, str[N], String        string
+//	Bytes, raw untyped slice   []byte
+//	raw untyped ptr            uint64
+//	array, tuple, Vec          []any
+//	struct                     map[string]any of the fields
+//	Option                     nil for None, the value for Some
+//	enum                       map[string]any with the variant name as the only key
+func Decode(typ *Type, data []byte) (any, error) {
+	d := &decoder{data: data}
+	value, err := d.decode(typ)
+	if err != nil {
+		return nil, err
+	}
+	if d.pos != len(d.data) {
+		return nil, fmt.Errorf("%d bytes left after decoding", len(d.data)-d.pos)
+	}
+	return value, nil
+}
+
+type decoder struct {
+	data []byte
+	pos  int
+}
+
+func (d *decoder) take(n uint64) ([]byte, error) {
+	if n > uint64(len(d.data)-d.pos) {
+		return nil, fmt.Errorf("need %d bytes at offset %d but only %d left", n, d.pos, len(d.data)-d.pos)
+	}
+	b := d.data[d.pos : d.pos+int(n)]
+	d.pos += int(n)
+	return b, nil
+}
+
+func (d *decoder) uint64() (uint64, error) {
+	b, err := d.take(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(b), nil
+}
+
+// length reads the length prefix of the dynamic types
+func (d *decoder) length() (uint64, error) {
+	n, err := d.uint64()
+	if err != nil {
+		return 0, fmt.Errorf("read length failed: %w", err)
+	}
+	return n, nil
+}
+
+// maxZeroSizeElements limits the number of the elements which are encoded as nothing, such as the elements of Vec<()>
+const maxZeroSizeElements = 1 << 16
+
+// minSize returns the least number of bytes to encode a value of the type
+func minSize(typ *Type) uint64 {
+	switch typ.Kind {
+	case KindBool:
+		return 1
+	case KindUint:
+		return uint64(typ.Bits / 8)
+	case KindB256:
+		return 32
+	case KindStrArray:
+		return uint64(typ.Len)
+	case KindArray:
+		return uint64(typ.Len) * minSize(typ.Elem)
+	case KindTuple, KindStruct:
+		var size uint64
+		for _, field := range typ.Fields {
+			size += minSize(field.Type)
+		}
+		return size
+	case KindRawPtr, KindStr, KindString, KindBytes, KindRawSlice, KindVec, KindEnum, KindOption:
+		// the length prefix or the variant index
+		return 8
+	}
+	return 0
+}
+
+func (d *decoder) list(elem *Type, n uint64) ([]any, error) {
+	// the length may come from the untrusted data
+	if size := minSize(elem); size == 0 && n > maxZeroSizeElements {
+		return nil, fmt.Errorf("%d zero size elements exceed the limit %d", n, maxZeroSizeElements)
+	} else if size > 0 && n > uint64(len(d.data)-d.pos)/size {
+		return nil, fmt.Errorf("%d elements need at least %d bytes each at offset %d but only %d left",
+			n, size, d.pos, len(d.data)-d.pos)
+	}
+	list := make([]any, 0, n)
+	for i := uint64(0); i < n; i++ {
+		value, err := d.decode(elem)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		list = append(list, value)
+	}
+	return list, nil
+}
+
+func (d *decoder) decode(typ *Type) (any, error) {
+	switch typ.Kind {
+	case KindUnit:
+		return nil, nil
+	case KindBool:
+		b, err := d.take(1)
+		if err != nil {
+			return nil, err
+		}
+		if b[0] > 1 {
+			return nil, fmt.Errorf("invalid bool value %d", b[0])
+		}
+		return b[0] == 1, nil
+	case KindUint:
+		b, err := d.take(uint64(typ.Bits / 8))
+		if err != nil {
+			return nil, err
+		}
+		switch typ.Bits {
+		case 8:
+			return b[0], nil
+		case 16:
+			return binary.BigEndian.Uint16(b), nil
+		case 32:
+			return binary.BigEndian.Uint32(b), nil
+		case 64:
+			return binary.BigEndian.Uint64(b), nil
+		default:
+			return new(big.Int).SetBytes(b), nil
+		}
+	case KindB256:
+		b, err := d.take(32)
+		if err != nil {
+			return nil, err
+		}
+		return common.BytesToHash(b), nil
+	case KindRawPtr:
+		return d.uint64()
+	case KindStrArray:
+		b, err := d.take(uint64(typ.Len))
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case KindStr, KindString, KindBytes, KindRawSlice:
+		n, err := d.length()
+		if err != nil {
+			return nil, err
+		}
+		b, err := d.take(n)
+		if err != nil {
+			return nil, err
+		}
+		if typ.Kind == KindStr || typ.Kind == KindString {
+			return string(b), nil
+		}
+		return append([]byte{}, b...), nil
+	case KindArray:
+		return d.list(typ.Elem, uint64(typ.Len))
+	case KindVec:
+		n, err := d.length()
+		if err != nil {
+			return nil, err
+		}
+		return d.list(typ.Elem, n)
+	case KindTuple:
+		tuple := make([]any, len(typ.Fields))
+		for i, field := range typ.Fields {
+			value, err := d.decode(field.Type)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			tuple[i] = value
+		}
+		return tuple, nil
+	case KindStruct:
+		fields := make(map[string]any, len(typ.Fields))
+		for _, field := range typ.Fields {
+			value, err := d.decode(field.Type)
+			if err != nil {
+				return nil, fmt.Errorf("field %s.%s: %w", typ.Name, field.Name, err)
+			}
+			fields[field.Name] = value
+		}
+		return fields, nil
+	case KindEnum, KindOption:
+		index, err := d.uint64()
+		if err != nil {
+			return nil, fmt.Errorf("read variant of %s failed: %w", typ.Name, err)
+		}
+		if index >= uint64(len(typ.Fields)) {
+			return nil, fmt.Errorf("invalid variant %d of %s", index, typ.Name)
+		}
+		variant := typ.Fields[index]
+		value, err := d.decode(variant.Type)
+		if err != nil {
+			return nil, fmt.Errorf("variant %s::%s: %w", typ.Name, variant.Name, err)
+		}
+		if typ.Kind == KindOption {
+			return value, nil
+		}
+		return map[string]any{variant.Name: value}, nil
+	}
+	return nil, fmt.Errorf("unknown kind %d", typ.Kind)
+}
@@ -0,0 +1,197 @@
+package abi
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	strArrayPattern = regexp.MustCompile(`^str\[(\d+)]$`)
+	arrayPattern    = regexp.MustCompile(`^\[_; (\d+)]$`)
+)
+
+// resolver builds the Type of the concrete types and the metadata types with the generic parameters bound
+type resolver struct {
+	metadata map[int]*metadataType
+	concrete map[string]*concreteType
+	// concreteTypeId => Type
+	cache map[string]*Type
+}
+
+func (r *resolver) concreteType(id string) (*Type, error) {
+	if typ, has := r.cache[id]; has {
+		return typ, nil
+	}
+	ct, has := r.concrete[id]
+	if !has {
+		return nil, fmt.Errorf("concrete type %s not found", id)
+	}
+	var typ *Type
+	var err error
+	if ct.MetadataTypeId == nil {
+		if typ = primitiveType(ct.Type); typ == nil {
+			return nil, fmt.Errorf("concrete type %s %q has no metadata type", id, ct.Type)
+		}
+	} else {
+		args := make([]*Type, len(ct.TypeArguments))
+		for i, arg := range ct.TypeArguments {
+			if args[i], err = r.concreteType(arg); err != nil {
+				return nil, err
+			}
+		}
+		if typ, err = r.metadataType(*ct.MetadataTypeId, args); err != nil {
+			return nil, err
+		}
+	}
+	r.cache[id] = typ
+	return typ, nil
+}
+
+func (r *resolver) metadataType(id int, args []*Type) (*Type, error) {
+	mt, has := r.metadata[id]
+	if !has {
+		return nil, fmt.Errorf("metadata type %d not found", id)
+	}
+	if len(args) != len(mt.TypeParameters) {
+		return nil, fmt.Errorf("metadata type %d %q needs %d type arguments but got %d",
+			id, mt.Type, len(mt.TypeParameters), len(args))
+	}
+	env := make(map[int]*Type, len(args))
+	for i, param := range mt.TypeParameters {
+		env[param] = args[i]
+	}
+	typ, err := r.build(mt, env)
+	if err != nil {
+		return nil, fmt.Errorf("resolve %q failed: %w", mt.Type, err)
+	}
+	return typ, nil
+}
+
+// component resolves the type of the component, env holds the types bound to the generic parameters
+func (r *resolver) component(c component, env map[int]*Type) (*Type, error) {
+	ref, err := c.ref()
+	if err != nil {
+		return nil, err
+	}
+	if ref.metadata == nil {
+		return r.concreteType(ref.concrete)
+	}
+	if typ, has := env[*ref.metadata]; has {
+		return typ, nil
+	}
+	if mt, has := r.metadata[*ref.metadata]; has && strings.HasPrefix(mt.Type, "generic ") {
+		return nil, fmt.Errorf("generic parameter %q of component %q is not bound", mt.Type, c.Name)
+	}
+	args := make([]*Type, len(c.TypeArguments))
+	for i, arg := range c.TypeArguments {
+		if args[i], err = r.component(arg, env); err != nil {
+			return nil, err
+		}
+	}
+	return r.metadataType(*ref.metadata, args)
+}
+
+func (r *resolver) fields(mt *metadataType, env map[int]*Type) ([]Field, error) {
+	fields := make([]Field, len(mt.Components))
+	for i, c := range mt.Components {
+		typ, err := r.component(c, env)
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = Field{Name: c.Name, Type: typ}
+	}
+	return fields, nil
+}
+
+func (r *resolver) build(mt *metadataType, env map[int]*Type) (*Type, error) {
+	if typ := primitiveType(mt.Type); typ != nil {
+		return typ, nil
+	}
+	if m := arrayPattern.FindStringSubmatch(mt.Type); m != nil {
+		size, _ := strconv.Atoi(m[1])
+		if len(mt.Components) != 1 {
+			return nil, fmt.Errorf("array should have one component but got %d", len(mt.Components))
+		}
+		elem, err := r.component(mt.Components[0], env)
+		if err != nil {
+			return nil, err
+		}
+		return &Type{Kind: KindArray, Len: size, Elem: elem}, nil
+	}
+	if strings.HasPrefix(mt.Type, "(") && strings.HasSuffix(mt.Type, ")") {
+		fields, err := r.fields(mt, env)
+		if err != nil {
+			return nil, err
+		}
+		return &Type{Kind: KindTuple, Fields: fields}, nil
+	}
+	if name, is := strings.CutPrefix(mt.Type, "struct "); is {
+		switch name {
+		case "std::vec::Vec", "Vec":
+			if len(mt.TypeParameters) != 1 {
+				return nil, fmt.Errorf("vec should have one type parameter but got %d", len(mt.TypeParameters))
+			}
+			elem, has := env[mt.TypeParameters[0]]
+			if !has {
+				return nil, fmt.Errorf("element type of vec is not bound")
+			}
+			return &Type{Kind: KindVec, Name: name, Elem: elem}, nil
+		case "std::bytes::Bytes", "Bytes":
+			return &Type{Kind: KindBytes, Name: name}, nil
+		case "std::string::String", "String":
+			return &Type{Kind: KindString, Name: name}, nil
+		}
+		fields, err := r.fields(mt, env)
+		if err != nil {
+			return nil, err
+		}
+		return &Type{Kind: KindStruct, Name: name, Fields: fields}, nil
+	}
+	if name, is := strings.CutPrefix(mt.Type, "enum "); is {
+		fields, err := r.fields(mt, env)
+		if err != nil {
+			return nil, err
+		}
+		kind := KindEnum
+		if name == "std::option::Option" || name == "Option" {
+			kind = KindOption
+		}
+		return &Type{Kind: kind, Name: name, Fields: fields}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %q", mt.Type)
+}
+
+// primitiveType returns nil if the type is not a primitive type
+func primitiveType(typ string) *Type {
+	switch typ {
+	case "()":
+		return &Type{Kind: KindUnit}
+	case "bool":
+		return &Type{Kind: KindBool}
+	case "u8":
+		return &Type{Kind: KindUint, Bits: 8}
+	case "u16":
+		return &Type{Kind: KindUint, Bits: 16}
+	case "u32":
+		return &Type{Kind: KindUint, Bits: 32}
+	case "u64":
+		return &Type{Kind: KindUint, Bits: 64}
+	case "u256":
+		return &Type{Kind: KindUint, Bits: 256}
+	case "b256":
+		return &Type{Kind: KindB256}
+	case "str":
+		return &Type{Kind: KindStr}
+	case "raw untyped slice":
+		return &Type{Kind: KindRawSlice}
+	case "raw untyped ptr":
+		return &Type{Kind: KindRawPtr}
+	}
+	if m := strArrayPattern.FindStringSubmatch(typ); m != nil {
+		size, _ := strconv.Atoi(m[1])
+		return &Type{Kind: KindStrArray, Len: size}
+	}
+	return nil
+}
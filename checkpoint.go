@@ -0,0 +1,143 @@
+package fuel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/types"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Checkpoint identifies the last block processed by a consumer
+type Checkpoint struct {
+	Height  types.U32     `json:"height"`
+	BlockId types.BlockId `json:"blockId"`
+}
+
+func CheckpointOf(block *types.Block) Checkpoint {
+	return Checkpoint{Height: block.Height, BlockId: block.Id}
+}
+
+// CheckpointStore persists the checkpoint of a block consumer
+type CheckpointStore interface {
+	// Load returns nil if no checkpoint has been saved
+	Load(ctx context.Context) (*Checkpoint, error)
+	Save(ctx context.Context, checkpoint Checkpoint) error
+}
+
+// MemoryCheckpointStore keeps the checkpoint in memory, which is useful for tests and short-lived consumers
+type MemoryCheckpointStore struct {
+	mu         sync.Mutex
+	checkpoint *Checkpoint
+}
+
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{}
+}
+
+func (s *MemoryCheckpointStore) Load(context.Context) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.checkpoint == nil {
+		return nil, nil
+	}
+	checkpoint := *s.checkpoint
+	return &checkpoint, nil
+}
+
+func (s *MemoryCheckpointStore) Save(_ context.Context, checkpoint Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoint = &checkpoint
+	return nil
+}
+
+// FileCheckpointStore keeps the checkpoint in a JSON file,
+// the file is replaced atomically so a crash during Save will not corrupt it
+type FileCheckpointStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+func (s *FileCheckpointStore) Load(context.Context) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	raw, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read checkpoint file failed: %w", err)
+	}
+	var checkpoint Checkpoint
+	if err = json.Unmarshal(raw, &checkpoint); err != nil {
+		return nil, fmt.Errorf("parse checkpoint file %s failed: %w", s.path, err)
+	}
+	return &checkpoint, nil
+}
+
+func (s *FileCheckpointStore) Save(_ context.Context, checkpoint Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	raw, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("marshal checkpoint failed: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create checkpoint file failed: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(raw); err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("write checkpoint file failed: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("replace checkpoint file failed: %w", err)
+	}
+	return nil
+}
+
+// VerifyCheckpoint checks whether the block at the height of the checkpoint is still the same one,
+// BlockMismatchError will be returned if not
+func (c *Client) VerifyCheckpoint(ctx context.Context, checkpoint Checkpoint) error {
+	block, err := c.GetBlock(ctx, types.QueryBlockParams{Height: &checkpoint.Height}, GetBlockOption{})
+	if err != nil {
+		return err
+	}
+	if block == nil {
+		return fmt.Errorf("block %d of the checkpoint not found", checkpoint.Height)
+	}
+	if block.Id != checkpoint.BlockId {
+		return BlockMismatchError{Height: checkpoint.Height, Expected: checkpoint.BlockId, Actual: block.Id}
+	}
+	return nil
+}
+
+// ResumeFrom returns the height to start a backfill from, which is the one after the checkpoint in the store,
+// or from if there is no checkpoint. The checkpoint is verified by VerifyCheckpoint.
+func (c *Client) ResumeFrom(ctx context.Context, store CheckpointStore, from types.U32) (types.U32, error) {
+	checkpoint, err := store.Load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if checkpoint == nil {
+		return from, nil
+	}
+	if err = c.VerifyCheckpoint(ctx, *checkpoint); err != nil {
+		return 0, err
+	}
+	return checkpoint.Height + 1, nil
+}
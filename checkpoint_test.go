@@ -0,0 +1,101 @@
+package fuel
+
+import (
+	"context"
+	"errors"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testCheckpointStore(t *testing.T, store CheckpointStore) {
+	ctx := context.Background()
+	checkpoint, err := store.Load(ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, checkpoint)
+
+	for _, height := range []int{3, 10} {
+		assert.NoError(t, store.Save(ctx, Checkpoint{Height: types.U32(height), BlockId: testBlockId(height, 0)}))
+		checkpoint, err = store.Load(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &Checkpoint{Height: types.U32(height), BlockId: testBlockId(height, 0)}, checkpoint)
+	}
+}
+
+func Test_MemoryCheckpointStore(t *testing.T) {
+	testCheckpointStore(t, NewMemoryCheckpointStore())
+}
+
+func Test_FileCheckpointStore(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "checkpoint.json")
+	testCheckpointStore(t, NewFileCheckpointStore(path))
+
+	checkpoint, err := NewFileCheckpointStore(path).Load(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, types.U32(10), checkpoint.Height)
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	assert.NoError(t, os.WriteFile(path, []byte("{"), 0644))
+	_, err = NewFileCheckpointStore(path).Load(context.Background())
+	assert.ErrorContains(t, err, "parse checkpoint file")
+}
+
+func Test_ResumeFrom(t *testing.T) {
+	chain := &followerTestChain{head: 10}
+	cli := newFollowerTestServer(t, chain)
+	ctx := context.Background()
+	store := NewMemoryCheckpointStore()
+
+	from, err := cli.ResumeFrom(ctx, store, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, types.U32(2), from)
+
+	assert.NoError(t, store.Save(ctx, Checkpoint{Height: 5, BlockId: testBlockId(5, 0)}))
+	from, err = cli.ResumeFrom(ctx, store, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, types.U32(6), from)
+
+	chain.fork = 1
+	_, err = cli.ResumeFrom(ctx, store, 2)
+	var mismatch BlockMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+
+	assert.NoError(t, store.Save(ctx, Checkpoint{Height: 20, BlockId: testBlockId(20, 1)}))
+	_, err = cli.ResumeFrom(ctx, store, 2)
+	assert.EqualError(t, err, "block 20 of the checkpoint not found")
+}
+
+func Test_BlockFollowerAck(t *testing.T) {
+	chain := &followerTestChain{head: 10}
+	cli := newFollowerTestServer(t, chain)
+	ctx := context.Background()
+	store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+	opt := BlockFollowerOption{PollInterval: time.Millisecond}
+
+	f, err := cli.FollowBlocksFromStore(ctx, store, 3, opt)
+	assert.NoError(t, err)
+	assert.Error(t, f.Ack(ctx))
+	for f.Next() && f.Block().Height < 6 {
+		assert.NoError(t, f.Ack(ctx))
+	}
+	// block 6 is delivered but not acknowledged
+	assert.Equal(t, types.U32(6), f.Block().Height)
+	f.Close()
+	checkpoint, err := store.Load(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, &Checkpoint{Height: 5, BlockId: testBlockId(5, 0)}, checkpoint)
+
+	f, err = cli.FollowBlocksFromStore(ctx, store, 3, opt)
+	assert.NoError(t, err)
+	assert.True(t, f.Next())
+	assert.Equal(t, types.U32(6), f.Block().Height)
+	f.Close()
+
+	assert.Error(t, cli.FollowBlocks(ctx, 0, opt).Ack(ctx))
+}
@@ -5,15 +5,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/sentioxyz/fuel-go/query"
 	"io"
 	"net/http"
 	"time"
 )
 
 type Client struct {
-	endpoint   string
-	httpClient http.Client
-	logger     Logger
+	endpoint             string
+	subscriptionEndpoint string
+	httpClient           http.Client
+	logger               Logger
+	debug                bool
+	versionCache         versionCache
 }
 
 func NewClient(endpoint string) *Client {
@@ -24,6 +28,23 @@ func NewClientWithLogger(endpoint string, logger Logger) *Client {
 	return &Client{endpoint: endpoint, logger: logger}
 }
 
+// SetSubscriptionEndpoint sets the endpoint serving the subscriptions, default is the endpoint with suffix "-sub"
+func (c *Client) SetSubscriptionEndpoint(endpoint string) {
+	c.subscriptionEndpoint = endpoint
+}
+
+func (c *Client) subscriptionURL() string {
+	if c.subscriptionEndpoint != "" {
+		return c.subscriptionEndpoint
+	}
+	return c.endpoint + "-sub"
+}
+
+// SetDebug turns on the debug mode, in which every query is validated by ValidateQuery before being sent
+func (c *Client) SetDebug(debug bool) {
+	c.debug = debug
+}
+
 type QueryErrorLocation struct {
 	Line   int `json:"line"`
 	Column int `json:"column"`
@@ -53,7 +74,7 @@ func (e QueryError) String() string {
 
 type QueryErrors []QueryError
 
-func (e QueryErrors) Error() string {
+func (e QueryErrors) join() string {
 	var buf bytes.Buffer
 	for i, ei := range e {
 		if i > 0 {
@@ -61,13 +82,22 @@ func (e QueryErrors) Error() string {
 		}
 		buf.WriteString(ei.String())
 	}
-	return fmt.Sprintf("execute query failed: %s", buf.String())
+	return buf.String()
+}
+
+func (e QueryErrors) Error() string {
+	return fmt.Sprintf("execute query failed: %s", e.join())
 }
 
 func ExecuteQuery[DATA any](ctx context.Context, cli *Client, query string) (data DATA, err error) {
 	if cli.logger != nil {
 		cli.logger.Infof("execute query: %s", query)
 	}
+	if cli.debug {
+		if err = ValidateQuery(query); err != nil {
+			return data, err
+		}
+	}
 	start := time.Now()
 	var reqBody bytes.Buffer
 	if err = json.NewEncoder(&reqBody).Encode(map[string]any{"query": query}); err != nil {
@@ -109,3 +139,15 @@ func ExecuteQuery[DATA any](ctx context.Context, cli *Client, query string) (dat
 	}
 	return result.Data, nil
 }
+
+// ExecuteField executes a query with a single root field and returns the typed result of the field.
+// To query multiple fields in one round trip, execute Builder.GenQuery by ExecuteQuery with
+// map[string]json.RawMessage as the result type, then decode each field by Field.Decode.
+func ExecuteField[T any](ctx context.Context, cli *Client, field query.Field[T]) (result T, err error) {
+	var data map[string]json.RawMessage
+	data, err = ExecuteQuery[map[string]json.RawMessage](ctx, cli, query.Simple.GenQuery(field))
+	if err != nil {
+		return result, err
+	}
+	return field.Decode(data)
+}
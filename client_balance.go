@@ -0,0 +1,73 @@
+package fuel
+
+import (
+	"context"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/query"
+	"github.com/sentioxyz/fuel-go/types"
+)
+
+func (c *Client) GetBalance(ctx context.Context, param types.QueryBalanceParams) (types.Balance, error) {
+	q := fmt.Sprintf("{ balance(%s) { %s} }",
+		query.Simple.GenParam(param),
+		query.Simple.GenObjectQuery(types.Balance{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		Balance types.Balance `json:"balance"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return types.Balance{}, err
+	}
+	return result.Balance, nil
+}
+
+// BalancesPaginator returns a paginator of the balances of all the assets of the owner
+func (c *Client) BalancesPaginator(owner types.Address, opt PaginatorOption) *Paginator[types.Balance] {
+	params := types.QueryBalancesParams{Filter: types.BalanceFilterInput{Owner: owner}}
+	return NewPaginator[types.Balance](c, "balances", params, query.IgnoreChecker{}, opt)
+}
+
+// GetBalances returns the balances of all the assets of the owner, all the pages will be fetched
+func (c *Client) GetBalances(ctx context.Context, owner types.Address) ([]types.Balance, error) {
+	return c.BalancesPaginator(owner, PaginatorOption{}).All(ctx)
+}
+
+func (c *Client) GetCoin(ctx context.Context, param types.QueryCoinParams) (*types.Coin, error) {
+	q := fmt.Sprintf("{ coin(%s) { %s} }",
+		query.Simple.GenParam(param),
+		query.Simple.GenObjectQuery(types.Coin{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		Coin *types.Coin `json:"coin"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	return result.Coin, nil
+}
+
+// CoinsPaginator returns a paginator of the unspent coins matching the filter
+func (c *Client) CoinsPaginator(filter types.CoinFilterInput, opt PaginatorOption) *Paginator[types.Coin] {
+	params := types.QueryCoinsParams{Filter: filter}
+	return NewPaginator[types.Coin](c, "coins", params, query.IgnoreChecker{}, opt)
+}
+
+// GetCoins returns the unspent coins of the owner, all the pages will be fetched.
+// If assetIds is not empty, only the coins of these assets will be returned.
+func (c *Client) GetCoins(ctx context.Context, owner types.Address, assetIds ...types.AssetId) ([]types.Coin, error) {
+	if len(assetIds) == 0 {
+		return c.CoinsPaginator(types.CoinFilterInput{Owner: owner}, PaginatorOption{}).All(ctx)
+	}
+	var coins []types.Coin
+	for _, assetId := range assetIds {
+		filter := types.CoinFilterInput{Owner: owner, AssetId: &assetId}
+		assetCoins, err := c.CoinsPaginator(filter, PaginatorOption{}).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		coins = append(coins, assetCoins...)
+	}
+	return coins, nil
+}
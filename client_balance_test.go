@@ -0,0 +1,101 @@
+package fuel
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+var (
+	testOwner  = types.Address{Hash: common.HexToHash("0xaa")}
+	testAsset1 = types.AssetId{Hash: common.HexToHash("0x01")}
+	testAsset2 = types.AssetId{Hash: common.HexToHash("0x02")}
+)
+
+func testCoin(utxo string, asset types.AssetId, amount string) map[string]any {
+	return map[string]any{
+		"utxoId":       utxo,
+		"owner":        testOwner.String(),
+		"amount":       amount,
+		"assetId":      asset.String(),
+		"blockCreated": "1",
+		"txCreatedIdx": "0",
+	}
+}
+
+func Test_GetBalance(t *testing.T) {
+	srv := newTestServer(t, func(q string) any {
+		if strings.Contains(q, "balances(") {
+			page := map[string]any{"hasPreviousPage": false, "hasNextPage": true, "endCursor": "c1"}
+			edge := map[string]any{"cursor": "c1", "node": map[string]any{
+				"owner": testOwner.String(), "amount": "10", "assetId": testAsset1.String(),
+			}}
+			if strings.Contains(q, `after: "c1"`) {
+				page = map[string]any{"hasPreviousPage": true, "hasNextPage": false, "endCursor": "c2"}
+				edge = map[string]any{"cursor": "c2", "node": map[string]any{
+					"owner": testOwner.String(), "amount": "20", "assetId": testAsset2.String(),
+				}}
+			}
+			return map[string]any{"balances": map[string]any{"pageInfo": page, "edges": []any{edge}}}
+		}
+		return map[string]any{"balance": map[string]any{
+			"owner": testOwner.String(), "amount": "10", "assetId": testAsset1.String(),
+		}}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+
+	balance, err := cli.GetBalance(context.Background(), types.QueryBalanceParams{Owner: testOwner, AssetId: testAsset1})
+	assert.NoError(t, err)
+	assert.Equal(t, types.Balance{Owner: testOwner, Amount: 10, AssetId: testAsset1}, balance)
+
+	balances, err := cli.GetBalances(context.Background(), testOwner)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.Balance{
+		{Owner: testOwner, Amount: 10, AssetId: testAsset1},
+		{Owner: testOwner, Amount: 20, AssetId: testAsset2},
+	}, balances)
+}
+
+func Test_GetCoins(t *testing.T) {
+	var queries []string
+	srv := newTestServer(t, func(q string) any {
+		queries = append(queries, q)
+		if strings.Contains(q, "coin(") {
+			return map[string]any{"coin": nil}
+		}
+		var edges []any
+		for _, coin := range []map[string]any{
+			testCoin("0x0100", testAsset1, "1"),
+			testCoin("0x0200", testAsset2, "2"),
+			testCoin("0x0300", testAsset2, "3"),
+		} {
+			if !strings.Contains(q, "assetId:") || strings.Contains(q, coin["assetId"].(string)) {
+				edges = append(edges, map[string]any{"cursor": coin["utxoId"], "node": coin})
+			}
+		}
+		return map[string]any{"coins": map[string]any{
+			"pageInfo": map[string]any{"hasPreviousPage": false, "hasNextPage": false},
+			"edges":    edges,
+		}}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+
+	coin, err := cli.GetCoin(context.Background(), types.QueryCoinParams{UtxoId: types.UtxoId{Bytes: []byte{1, 0}}})
+	assert.NoError(t, err)
+	assert.Nil(t, coin)
+
+	coins, err := cli.GetCoins(context.Background(), testOwner)
+	assert.NoError(t, err)
+	assert.Len(t, coins, 3)
+
+	coins, err = cli.GetCoins(context.Background(), testOwner, testAsset2)
+	assert.NoError(t, err)
+	assert.Len(t, coins, 2)
+	assert.Equal(t, types.U64(3), coins[1].Amount)
+	assert.Contains(t, queries[len(queries)-1], `filter: { owner: "`+testOwner.String()+`" assetId: "`+testAsset2.String()+`" } first: 100 `)
+}
@@ -0,0 +1,88 @@
+package fuel
+
+import (
+	"context"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/query"
+	"github.com/sentioxyz/fuel-go/types"
+	"strings"
+)
+
+// GetBlob returns nil if the blob does not exist
+func (c *Client) GetBlob(ctx context.Context, param types.QueryBlobParams) (*types.Blob, error) {
+	q := fmt.Sprintf("{ blob(%s) { %s} }",
+		query.Simple.GenParam(param),
+		query.Simple.GenObjectQuery(types.Blob{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		Blob *types.Blob `json:"blob"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	return result.Blob, nil
+}
+
+// GetBlobs fetches all the blobs in one request, the blob will be nil if it does not exist
+func (c *Client) GetBlobs(ctx context.Context, params []types.QueryBlobParams) ([]*types.Blob, error) {
+	bqs := make([]string, len(params))
+	selection := query.Simple.GenObjectQuery(types.Blob{}, query.IgnoreChecker{})
+	for i, param := range params {
+		bqs[i] = fmt.Sprintf("b%d:blob(%s) { %s}", i, query.Simple.GenParam(param), selection)
+	}
+	q := "{" + strings.Join(bqs, " ") + " }"
+	type resultType map[string]*types.Blob
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]*types.Blob, len(params))
+	for i := range params {
+		blobs[i] = result[fmt.Sprintf("b%d", i)]
+	}
+	return blobs, nil
+}
+
+// GetDaCompressedBlock returns nil if the compressed block of the height does not exist
+func (c *Client) GetDaCompressedBlock(
+	ctx context.Context,
+	param types.QueryDaCompressedBlockParams,
+) (*types.DaCompressedBlock, error) {
+	q := fmt.Sprintf("{ daCompressedBlock(%s) { %s} }",
+		query.Simple.GenParam(param),
+		query.Simple.GenObjectQuery(types.DaCompressedBlock{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		DaCompressedBlock *types.DaCompressedBlock `json:"daCompressedBlock"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	return result.DaCompressedBlock, nil
+}
+
+// GetDaCompressedBlocks fetches all the compressed blocks in one request,
+// the compressed block will be nil if it does not exist
+func (c *Client) GetDaCompressedBlocks(
+	ctx context.Context,
+	params []types.QueryDaCompressedBlockParams,
+) ([]*types.DaCompressedBlock, error) {
+	dqs := make([]string, len(params))
+	selection := query.Simple.GenObjectQuery(types.DaCompressedBlock{}, query.IgnoreChecker{})
+	for i, param := range params {
+		dqs[i] = fmt.Sprintf("d%d:daCompressedBlock(%s) { %s}", i, query.Simple.GenParam(param), selection)
+	}
+	q := "{" + strings.Join(dqs, " ") + " }"
+	type resultType map[string]*types.DaCompressedBlock
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]*types.DaCompressedBlock, len(params))
+	for i := range params {
+		blocks[i] = result[fmt.Sprintf("d%d", i)]
+	}
+	return blocks, nil
+}
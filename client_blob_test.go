@@ -0,0 +1,87 @@
+package fuel
+
+import (
+	"context"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+)
+
+func Test_GetBlobs(t *testing.T) {
+	var queries []string
+	srv := newTestServer(t, func(q string) any {
+		queries = append(queries, q)
+		data := make(map[string]any)
+		for _, m := range regexp.MustCompile(`(?:(b\d+):)?blob\(id: "(0x[0-9a-f]*)" \)`).FindAllStringSubmatch(q, -1) {
+			key := m[1]
+			if key == "" {
+				key = "blob"
+			}
+			if m[2] == "0x00" {
+				data[key] = nil
+			} else {
+				data[key] = map[string]any{"id": m[2], "bytecode": "0x1234"}
+			}
+		}
+		return data
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	ctx := context.Background()
+
+	blob, err := cli.GetBlob(ctx, types.QueryBlobParams{Id: types.BlobId{Bytes: []byte{1}}})
+	assert.NoError(t, err)
+	assert.Equal(t, &types.Blob{Id: types.BlobId{Bytes: []byte{1}}, Bytecode: types.HexString{Bytes: []byte{0x12, 0x34}}}, blob)
+
+	blobs, err := cli.GetBlobs(ctx, []types.QueryBlobParams{
+		{Id: types.BlobId{Bytes: []byte{2}}},
+		{Id: types.BlobId{Bytes: []byte{0}}},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, blobs, 2)
+	assert.Equal(t, types.BlobId{Bytes: []byte{2}}, blobs[0].Id)
+	assert.Nil(t, blobs[1])
+	assert.Len(t, queries, 2)
+}
+
+func Test_GetDaCompressedBlocks(t *testing.T) {
+	var queries []string
+	srv := newTestServer(t, func(q string) any {
+		queries = append(queries, q)
+		data := make(map[string]any)
+		for _, m := range regexp.MustCompile(`(?:(d\d+):)?daCompressedBlock\(height: "(\d+)" \)`).FindAllStringSubmatch(q, -1) {
+			key := m[1]
+			if key == "" {
+				key = "daCompressedBlock"
+			}
+			if m[2] == "0" {
+				data[key] = nil
+			} else {
+				data[key] = map[string]any{"bytes": fmt.Sprintf("0x%02x", len(m[2]))}
+			}
+		}
+		return data
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	ctx := context.Background()
+
+	block, err := cli.GetDaCompressedBlock(ctx, types.QueryDaCompressedBlockParams{Height: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, &types.DaCompressedBlock{Bytes: types.HexString{Bytes: []byte{1}}}, block)
+
+	blocks, err := cli.GetDaCompressedBlocks(ctx, []types.QueryDaCompressedBlockParams{
+		{Height: 10},
+		{Height: 0},
+		{Height: 100},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.DaCompressedBlock{
+		{Bytes: types.HexString{Bytes: []byte{2}}},
+		nil,
+		{Bytes: types.HexString{Bytes: []byte{3}}},
+	}, blocks)
+	assert.Len(t, queries, 2)
+}
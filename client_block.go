@@ -72,12 +72,9 @@ func (c *Client) GetBlocks(
 	opt GetBlockOption,
 ) ([]*types.Block, error) {
 	bqs := make([]string, len(params))
+	selection := query.Simple.GenObjectQuery(types.Block{}, opt.BuildIgnoreChecker())
 	for i, param := range params {
-		bqs[i] = fmt.Sprintf("b%d:block(%s) { %s}",
-			i,
-			query.Simple.GenParam(param),
-			query.Simple.GenObjectQuery(types.Block{}, opt.BuildIgnoreChecker()),
-		)
+		bqs[i] = fmt.Sprintf("b%d:block(%s) { %s}", i, query.Simple.GenParam(param), selection)
 	}
 	q := "{" + strings.Join(bqs, " ") + " }"
 	type resultType map[string]*types.Block
@@ -0,0 +1,307 @@
+package fuel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/types"
+	"time"
+)
+
+const (
+	DefaultFollowPollInterval    = time.Second
+	DefaultFollowMaxPollInterval = time.Second * 30
+)
+
+// BlockFollowerOption controls the following. The new blocks are fetched in ranges of at most
+// ChunkSize * Concurrency blocks, the last block of each range is fetched again before it is delivered,
+// and the last delivered block is fetched again at the beginning of the next range, BlockMismatchError will be
+// returned if any of their ids is changed.
+// Note that the blocks are not linked to their parents, because Header.PrevRoot is the merkle root of all the
+// previous block ids and can not be checked without the ids since the genesis. So a chain switch happening
+// while a range is being fetched is missed if it does not change the last block of the range fetched before,
+// the delivered blocks in the range before the last one may be from the old chain.
+type BlockFollowerOption struct {
+	// Used to fetch the new blocks
+	FetchBlockRangeOption
+	// Wait before polling the latest height again if there is no new block, DefaultFollowPollInterval will be used
+	// if it is not positive. The interval is doubled for each empty or failed poll until it reaches MaxPollInterval,
+	// and reset after new blocks are found.
+	PollInterval time.Duration
+	// DefaultFollowMaxPollInterval will be used if it is not positive
+	MaxPollInterval time.Duration
+	// Number of consecutive failed polls tolerated before the following is stopped,
+	// retry forever with the backoff if it is not positive
+	MaxPollFailures int
+}
+
+// BlockMismatchError means the block at the height is not the one seen before,
+// usually the node has been switched to another chain
+type BlockMismatchError struct {
+	Height   types.U32
+	Expected types.BlockId
+	// Zero if the block is not found
+	Actual types.BlockId
+}
+
+func (e BlockMismatchError) Error() string {
+	return fmt.Sprintf("block %d mismatch: expected %s but got %s", e.Height, e.Expected, e.Actual)
+}
+
+// BlockFollower delivers the blocks in order from a height and then follows the chain head, the usage is like:
+//
+//	for f.Next() {
+//	  block := f.Block()
+//	}
+//	if err := f.Err(); err != nil {
+//	  ...
+//	}
+//
+// The ids of the blocks are checked as described in BlockFollowerOption.
+// The following will be stopped if the context of it is canceled or Close is called.
+type BlockFollower struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cli    *Client
+	opt    BlockFollowerOption
+	store  CheckpointStore
+
+	// height of the next block to deliver
+	next   types.U32
+	last   *Checkpoint
+	blocks *BlockRange
+	// max number of the new blocks in a range, and the height of the last block of the fetching range
+	rangeSize types.U32
+	rangeEnd  types.U32
+	current   *types.Block
+	interval  time.Duration
+	failures  int
+	done      bool
+	err       error
+}
+
+// FollowBlocks returns a follower which delivers the blocks from the height
+func (c *Client) FollowBlocks(ctx context.Context, from types.U32, opt BlockFollowerOption) *BlockFollower {
+	return c.newBlockFollower(ctx, from, nil, opt)
+}
+
+// ResumeBlockFollower returns a follower which delivers the blocks after the checkpoint,
+// BlockMismatchError will be returned if the block at the height of the checkpoint has another id
+func (c *Client) ResumeBlockFollower(ctx context.Context, checkpoint Checkpoint, opt BlockFollowerOption) *BlockFollower {
+	return c.newBlockFollower(ctx, checkpoint.Height+1, &checkpoint, opt)
+}
+
+// FollowBlocksFromStore resumes from the checkpoint in the store, or follows from the height if there is no checkpoint.
+// The checkpoint is saved only by Ack, so the blocks not acknowledged will be delivered again after a restart.
+func (c *Client) FollowBlocksFromStore(
+	ctx context.Context,
+	store CheckpointStore,
+	from types.U32,
+	opt BlockFollowerOption,
+) (*BlockFollower, error) {
+	checkpoint, err := store.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var f *BlockFollower
+	if checkpoint == nil {
+		f = c.FollowBlocks(ctx, from, opt)
+	} else {
+		f = c.ResumeBlockFollower(ctx, *checkpoint, opt)
+	}
+	f.store = store
+	return f, nil
+}
+
+func (c *Client) newBlockFollower(
+	ctx context.Context,
+	from types.U32,
+	last *Checkpoint,
+	opt BlockFollowerOption,
+) *BlockFollower {
+	if opt.PollInterval <= 0 {
+		opt.PollInterval = DefaultFollowPollInterval
+	}
+	if opt.MaxPollInterval <= 0 {
+		opt.MaxPollInterval = DefaultFollowMaxPollInterval
+	}
+	chunkSize, concurrency := opt.ChunkSize, opt.Concurrency
+	if chunkSize <= 0 {
+		chunkSize = DefaultFetchChunkSize
+	}
+	if concurrency <= 0 {
+		concurrency = DefaultFetchConcurrency
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &BlockFollower{
+		ctx:       ctx,
+		cancel:    cancel,
+		cli:       c,
+		opt:       opt,
+		next:      from,
+		last:      last,
+		rangeSize: types.U32(chunkSize * concurrency),
+		interval:  opt.PollInterval,
+	}
+}
+
+func (f *BlockFollower) fail(err error) bool {
+	if f.blocks != nil {
+		f.blocks.Close()
+		f.blocks = nil
+	}
+	f.cancel()
+	f.done, f.err = true, err
+	return false
+}
+
+// accept checks the height of the block and the id of the last delivered one,
+// returns false if the block is the last delivered one
+func (f *BlockFollower) accept(block *types.Block) (bool, error) {
+	if f.last != nil && block.Height == f.last.Height {
+		if block.Id != f.last.BlockId {
+			return false, BlockMismatchError{Height: block.Height, Expected: f.last.BlockId, Actual: block.Id}
+		}
+		return false, nil
+	}
+	if block.Height != f.next {
+		return false, fmt.Errorf("got block %d while expecting block %d", block.Height, f.next)
+	}
+	return true, nil
+}
+
+// verify fetches the block again, the chain is switched while the range is being fetched if the id is changed
+func (f *BlockFollower) verify(block *types.Block) error {
+	height := block.Height
+	latest, err := f.cli.GetBlock(f.ctx, types.QueryBlockParams{Height: &height}, GetBlockOption{})
+	if err != nil {
+		return fmt.Errorf("verify block %d failed: %w", height, err)
+	}
+	var actual types.BlockId
+	if latest != nil {
+		actual = latest.Id
+	}
+	if actual != block.Id {
+		return BlockMismatchError{Height: height, Expected: block.Id, Actual: actual}
+	}
+	return nil
+}
+
+// wait sleeps for the poll interval and then doubles it
+func (f *BlockFollower) wait() error {
+	select {
+	case <-f.ctx.Done():
+		return f.ctx.Err()
+	case <-time.After(f.interval):
+	}
+	f.interval = min(f.interval*2, f.opt.MaxPollInterval)
+	return nil
+}
+
+// pollFailed waits before the next poll, returns an error if the following should be stopped
+func (f *BlockFollower) pollFailed(err error) error {
+	if f.ctx.Err() != nil {
+		return f.ctx.Err()
+	}
+	f.failures++
+	if f.opt.MaxPollFailures > 0 && f.failures > f.opt.MaxPollFailures {
+		return err
+	}
+	return f.wait()
+}
+
+// Next waits for the next block, returns false if an error occurred or the follower is closed
+func (f *BlockFollower) Next() bool {
+	for !f.done {
+		if f.blocks != nil {
+			if f.blocks.Next() {
+				block := f.blocks.Block()
+				if fresh, err := f.accept(block); err != nil {
+					return f.fail(err)
+				} else if !fresh {
+					continue
+				}
+				if block.Height == f.rangeEnd {
+					var mismatch BlockMismatchError
+					if err := f.verify(block); errors.As(err, &mismatch) {
+						return f.fail(err)
+					} else if err != nil {
+						f.blocks.Close()
+						f.blocks = nil
+						if err = f.pollFailed(err); err != nil {
+							return f.fail(err)
+						}
+						continue
+					}
+				}
+				f.current, f.next = block, block.Height+1
+				checkpoint := CheckpointOf(block)
+				f.last = &checkpoint
+				f.failures, f.interval = 0, f.opt.PollInterval
+				return true
+			}
+			err := f.blocks.Err()
+			f.blocks = nil
+			if err != nil {
+				if err = f.pollFailed(err); err != nil {
+					return f.fail(err)
+				}
+			}
+			continue
+		}
+		head, err := f.cli.GetLatestBlockHeight(f.ctx)
+		if err != nil {
+			err = f.pollFailed(fmt.Errorf("get latest block height failed: %w", err))
+		} else if head >= f.next {
+			from := f.next
+			if f.last != nil {
+				from = f.last.Height
+			}
+			f.rangeEnd = min(head, f.next+f.rangeSize-1)
+			f.blocks = f.cli.FetchBlockRange(f.ctx, from, f.rangeEnd, f.opt.FetchBlockRangeOption)
+			continue
+		} else {
+			f.failures = 0
+			err = f.wait()
+		}
+		if err != nil {
+			return f.fail(err)
+		}
+	}
+	return false
+}
+
+// Block returns the block got by the last successful Next
+func (f *BlockFollower) Block() *types.Block {
+	return f.current
+}
+
+// Checkpoint returns the last delivered block, which can be used to resume the following by ResumeBlockFollower,
+// nil if no block has been delivered and the follower is not resumed from a checkpoint
+func (f *BlockFollower) Checkpoint() *Checkpoint {
+	return f.last
+}
+
+// Ack saves the checkpoint of the block got by the last successful Next into the store,
+// the follower should be created by FollowBlocksFromStore
+func (f *BlockFollower) Ack(ctx context.Context) error {
+	if f.store == nil {
+		return fmt.Errorf("no checkpoint store for the follower")
+	}
+	if f.current == nil {
+		return fmt.Errorf("no block to acknowledge")
+	}
+	return f.store.Save(ctx, CheckpointOf(f.current))
+}
+
+// Err returns the error stopped the following
+func (f *BlockFollower) Err() error {
+	return f.err
+}
+
+// Close stops the following
+func (f *BlockFollower) Close() {
+	if !f.done {
+		f.fail(nil)
+	}
+}
@@ -0,0 +1,217 @@
+package fuel
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type followerTestChain struct {
+	lock      sync.Mutex
+	head      int
+	fork      int64
+	chainErrs int
+	blockErrs int
+	polls     int
+	// switch to the next fork after serving the number of block queries, never switch if it is 0
+	switchAfter int
+}
+
+func (c *followerTestChain) setHead(head int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.head = head
+}
+
+func testBlockId(height int, fork int64) types.BlockId {
+	return types.BlockId{Hash: common.BigToHash(big.NewInt(fork<<32 + int64(height) + 1))}
+}
+
+func newFollowerTestServer(t *testing.T, chain *followerTestChain) *Client {
+	blockPattern := regexp.MustCompile(`(?:(b\d+):)?block\(height: "(\d+)" \)`)
+	srv := newTestServer(t, func(q string) any {
+		chain.lock.Lock()
+		defer chain.lock.Unlock()
+		if strings.HasPrefix(q, "{ chain {") {
+			chain.polls++
+			if chain.chainErrs > 0 {
+				chain.chainErrs--
+				return QueryErrors{{Message: "service unavailable"}}
+			}
+			return map[string]any{"chain": map[string]any{"latestBlock": map[string]any{"height": strconv.Itoa(chain.head)}}}
+		}
+		if chain.blockErrs > 0 {
+			chain.blockErrs--
+			return QueryErrors{{Message: "service unavailable"}}
+		}
+		if chain.switchAfter > 0 {
+			if chain.switchAfter--; chain.switchAfter == 0 {
+				defer func() { chain.fork++ }()
+			}
+		}
+		data := make(map[string]any)
+		for _, m := range blockPattern.FindAllStringSubmatch(q, -1) {
+			height, _ := strconv.Atoi(m[2])
+			key := m[1]
+			if key == "" {
+				key = "block"
+			}
+			if height > chain.head {
+				data[key] = nil
+				continue
+			}
+			data[key] = map[string]any{"height": m[2], "id": testBlockId(height, chain.fork).String()}
+		}
+		return data
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	return cli
+}
+
+func Test_BlockFollower(t *testing.T) {
+	chain := &followerTestChain{head: 4}
+	cli := newFollowerTestServer(t, chain)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	f := cli.FollowBlocks(ctx, 2, BlockFollowerOption{
+		FetchBlockRangeOption: FetchBlockRangeOption{ChunkSize: 2},
+		PollInterval:          time.Millisecond,
+		MaxPollInterval:       time.Millisecond * 4,
+	})
+	assert.Nil(t, f.Checkpoint())
+	var heights []types.U32
+	for len(heights) < 3 && f.Next() {
+		heights = append(heights, f.Block().Height)
+	}
+	assert.Equal(t, []types.U32{2, 3, 4}, heights)
+	assert.Equal(t, &Checkpoint{Height: 4, BlockId: testBlockId(4, 0)}, f.Checkpoint())
+
+	go func() {
+		time.Sleep(time.Millisecond * 50)
+		chain.setHead(7)
+	}()
+	for len(heights) < 6 && f.Next() {
+		heights = append(heights, f.Block().Height)
+	}
+	assert.NoError(t, f.Err())
+	assert.Equal(t, []types.U32{2, 3, 4, 5, 6, 7}, heights)
+	chain.lock.Lock()
+	assert.Greater(t, chain.polls, 3)
+	chain.lock.Unlock()
+	f.Close()
+	assert.False(t, f.Next())
+	assert.NoError(t, f.Err())
+
+	// resume from the checkpoint
+	checkpoint := Checkpoint{Height: 5, BlockId: testBlockId(5, 0)}
+	f = cli.ResumeBlockFollower(ctx, checkpoint, BlockFollowerOption{})
+	assert.True(t, f.Next())
+	assert.Equal(t, types.U32(6), f.Block().Height)
+	f.Close()
+
+	// the chain is switched
+	chain.lock.Lock()
+	chain.fork = 1
+	chain.lock.Unlock()
+	f = cli.ResumeBlockFollower(ctx, checkpoint, BlockFollowerOption{})
+	assert.False(t, f.Next())
+	var mismatch BlockMismatchError
+	assert.True(t, errors.As(f.Err(), &mismatch))
+	assert.Equal(t, BlockMismatchError{Height: 5, Expected: testBlockId(5, 0), Actual: testBlockId(5, 1)}, mismatch)
+
+	// the chain is switched while following
+	f = cli.FollowBlocks(ctx, 7, BlockFollowerOption{PollInterval: time.Millisecond})
+	assert.True(t, f.Next())
+	assert.Equal(t, types.U32(7), f.Block().Height)
+	chain.lock.Lock()
+	chain.fork, chain.head = 2, 8
+	chain.lock.Unlock()
+	assert.False(t, f.Next())
+	assert.True(t, errors.As(f.Err(), &mismatch))
+	assert.Equal(t, BlockMismatchError{Height: 7, Expected: testBlockId(7, 1), Actual: testBlockId(7, 2)}, mismatch)
+}
+
+func Test_BlockFollowerPollFailure(t *testing.T) {
+	chain := &followerTestChain{head: 1, chainErrs: 5, blockErrs: 3}
+	cli := newFollowerTestServer(t, chain)
+	opt := BlockFollowerOption{PollInterval: time.Millisecond, MaxPollInterval: time.Millisecond * 4}
+
+	// retry forever by default
+	f := cli.FollowBlocks(context.Background(), 0, opt)
+	assert.True(t, f.Next())
+	assert.Equal(t, types.U32(0), f.Block().Height)
+	f.Close()
+	chain.lock.Lock()
+	// 5 failed polls of the latest height, and 3 polls followed by failed fetches of the blocks
+	assert.Equal(t, 9, chain.polls)
+	chain.chainErrs, chain.blockErrs = 1, 1
+	chain.lock.Unlock()
+
+	opt.MaxPollFailures = 2
+	f = cli.FollowBlocks(context.Background(), 0, opt)
+	assert.True(t, f.Next())
+	assert.Equal(t, types.U32(0), f.Block().Height)
+	f.Close()
+
+	chain.lock.Lock()
+	chain.chainErrs = 2
+	chain.lock.Unlock()
+	opt.MaxPollFailures = 1
+	f = cli.FollowBlocks(context.Background(), 0, opt)
+	assert.False(t, f.Next())
+	assert.ErrorContains(t, f.Err(), "get latest block height failed")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	f = cli.FollowBlocks(ctx, 2, opt)
+	go func() {
+		time.Sleep(time.Millisecond * 20)
+		cancel()
+	}()
+	assert.False(t, f.Next())
+	assert.ErrorIs(t, f.Err(), context.Canceled)
+}
+
+func Test_BlockFollowerSwitchInRange(t *testing.T) {
+	// the chain is switched after the range [0,2] is fetched
+	chain := &followerTestChain{head: 5, switchAfter: 1}
+	cli := newFollowerTestServer(t, chain)
+	f := cli.FollowBlocks(context.Background(), 0, BlockFollowerOption{
+		FetchBlockRangeOption: FetchBlockRangeOption{ChunkSize: 3, Concurrency: 1},
+		PollInterval:          time.Millisecond,
+	})
+	var heights []types.U32
+	for f.Next() {
+		heights = append(heights, f.Block().Height)
+	}
+	assert.Equal(t, []types.U32{0, 1}, heights)
+	var mismatch BlockMismatchError
+	assert.True(t, errors.As(f.Err(), &mismatch))
+	assert.Equal(t, BlockMismatchError{Height: 2, Expected: testBlockId(2, 0), Actual: testBlockId(2, 1)}, mismatch)
+
+	// the last block of each range is verified, the following continues if the chain is not switched
+	chain.lock.Lock()
+	chain.fork = 0
+	chain.lock.Unlock()
+	f = cli.FollowBlocks(context.Background(), 0, BlockFollowerOption{
+		FetchBlockRangeOption: FetchBlockRangeOption{ChunkSize: 3, Concurrency: 1},
+		PollInterval:          time.Millisecond,
+	})
+	heights = nil
+	for len(heights) < 6 && f.Next() {
+		heights = append(heights, f.Block().Height)
+	}
+	f.Close()
+	assert.NoError(t, f.Err())
+	assert.Equal(t, []types.U32{0, 1, 2, 3, 4, 5}, heights)
+}
@@ -0,0 +1,236 @@
+package fuel
+
+import (
+	"context"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/types"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultFetchChunkSize   = 50
+	DefaultFetchConcurrency = 4
+)
+
+type FetchBlockRangeOption struct {
+	GetBlockOption
+	// Number of blocks fetched in one request, DefaultFetchChunkSize will be used if it is not positive
+	ChunkSize int
+	// Max number of chunks being fetched or waiting to be read, DefaultFetchConcurrency will be used if it is not positive
+	Concurrency int
+	// Max times to retry a failed chunk, 0 means never retry
+	MaxRetries int
+	// Wait before each retry
+	RetryInterval time.Duration
+	// Fetch the chunks with the blocks connection using first/after cursors instead of the aliased block queries,
+	// which is usually cheaper for the node when the chunks are large
+	UseConnection bool
+	// Called after each chunk is read
+	OnProgress func(FetchProgress)
+}
+
+type FetchProgress struct {
+	// Height of the last block read
+	Height types.U32
+	// Number of the blocks read
+	Blocks int
+	// Average speed since the fetching started
+	BlocksPerSecond float64
+}
+
+type fetchedChunk struct {
+	blocks []*types.Block
+	err    error
+}
+
+// BlockRange reads the blocks in a height range in order, the chunks are prefetched in parallel.
+// The usage is like:
+//
+//	for r.Next() {
+//	  block := r.Block()
+//	}
+//	if err := r.Err(); err != nil {
+//	  ...
+//	}
+//
+// The prefetching will be stopped if the context of it is canceled or Close is called.
+type BlockRange struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	pending chan chan fetchedChunk
+	sem     chan struct{}
+	opt     FetchBlockRangeOption
+
+	startAt time.Time
+	blocks  []*types.Block
+	index   int
+	read    int
+	current *types.Block
+	done    bool
+	err     error
+}
+
+// FetchBlockRange returns the stream of the blocks with height in [from, to]
+func (c *Client) FetchBlockRange(ctx context.Context, from, to types.U32, opt FetchBlockRangeOption) *BlockRange {
+	if opt.ChunkSize <= 0 {
+		opt.ChunkSize = DefaultFetchChunkSize
+	}
+	if opt.Concurrency <= 0 {
+		opt.Concurrency = DefaultFetchConcurrency
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	r := &BlockRange{
+		ctx:     ctx,
+		cancel:  cancel,
+		pending: make(chan chan fetchedChunk, opt.Concurrency),
+		sem:     make(chan struct{}, opt.Concurrency),
+		opt:     opt,
+		startAt: time.Now(),
+	}
+	go func() {
+		defer close(r.pending)
+		for start := uint64(from); start <= uint64(to); start += uint64(opt.ChunkSize) {
+			end := min(start+uint64(opt.ChunkSize)-1, uint64(to))
+			select {
+			case r.sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			result := make(chan fetchedChunk, 1)
+			r.pending <- result
+			go func(from, to types.U32) {
+				blocks, err := c.fetchChunkWithRetry(ctx, from, to, opt)
+				result <- fetchedChunk{blocks: blocks, err: err}
+			}(types.U32(start), types.U32(end))
+		}
+	}()
+	return r
+}
+
+func (c *Client) fetchChunkWithRetry(
+	ctx context.Context,
+	from, to types.U32,
+	opt FetchBlockRangeOption,
+) ([]*types.Block, error) {
+	for retries := 0; ; retries++ {
+		blocks, err := c.fetchChunk(ctx, from, to, opt)
+		if err == nil {
+			return blocks, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if retries >= opt.MaxRetries {
+			return nil, fmt.Errorf("fetch blocks [%d, %d] failed: %w", from, to, err)
+		}
+		if c.logger != nil {
+			c.logger.Infof("fetch blocks [%d, %d] failed and will retry: %v", from, to, err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(opt.RetryInterval):
+		}
+	}
+}
+
+func (c *Client) fetchChunk(ctx context.Context, from, to types.U32, opt FetchBlockRangeOption) ([]*types.Block, error) {
+	var blocks []*types.Block
+	if opt.UseConnection {
+		// the cursor of the blocks connection is the height of the block
+		var cursor *types.String
+		if from > 0 {
+			after := types.String(strconv.FormatUint(uint64(from)-1, 10))
+			cursor = &after
+		}
+		size := int32(to - from + 1)
+		paginator := NewPaginator[types.Block](c, "blocks", types.QueryBlocksParams{}, opt.BuildIgnoreChecker(),
+			PaginatorOption{PageSize: size, Cursor: cursor})
+		page, err := paginator.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page.Edges {
+			blocks = append(blocks, &page.Edges[i].Node)
+		}
+	} else {
+		params := make([]types.QueryBlockParams, to-from+1)
+		for i := range params {
+			height := from + types.U32(i)
+			params[i].Height = &height
+		}
+		var err error
+		if blocks, err = c.GetBlocks(ctx, params, opt.GetBlockOption); err != nil {
+			return nil, err
+		}
+	}
+	for i := 0; i <= int(to-from); i++ {
+		if i >= len(blocks) || blocks[i] == nil {
+			return nil, fmt.Errorf("block %d not found", uint64(from)+uint64(i))
+		}
+		if blocks[i].Height != from+types.U32(i) {
+			return nil, fmt.Errorf("got block %d while expecting block %d", blocks[i].Height, uint64(from)+uint64(i))
+		}
+	}
+	return blocks, nil
+}
+
+func (r *BlockRange) fail(err error) bool {
+	r.cancel()
+	r.done, r.err = true, err
+	return false
+}
+
+// Next waits for the next block, returns false if all the blocks are read or an error occurred
+func (r *BlockRange) Next() bool {
+	for !r.done && r.index >= len(r.blocks) {
+		result, ok := <-r.pending
+		if !ok {
+			if err := r.ctx.Err(); err != nil {
+				return r.fail(err)
+			}
+			r.cancel()
+			r.done = true
+			return false
+		}
+		chunk := <-result
+		<-r.sem
+		if chunk.err != nil {
+			return r.fail(chunk.err)
+		}
+		r.blocks, r.index = chunk.blocks, 0
+	}
+	if r.done {
+		return false
+	}
+	r.current = r.blocks[r.index]
+	r.index++
+	r.read++
+	if r.index == len(r.blocks) && r.opt.OnProgress != nil {
+		r.opt.OnProgress(FetchProgress{
+			Height:          r.current.Height,
+			Blocks:          r.read,
+			BlocksPerSecond: float64(r.read) / time.Since(r.startAt).Seconds(),
+		})
+	}
+	return true
+}
+
+// Block returns the block got by the last successful Next
+func (r *BlockRange) Block() *types.Block {
+	return r.current
+}
+
+// Err returns the error stopped the reading, nil if all the blocks are read
+func (r *BlockRange) Err() error {
+	return r.err
+}
+
+// Close stops the prefetching, the blocks not read yet will be discarded
+func (r *BlockRange) Close() {
+	if !r.done {
+		r.fail(context.Canceled)
+		r.err = nil
+	}
+}
@@ -0,0 +1,140 @@
+package fuel
+
+import (
+	"context"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newBlockRangeTestServer serves the aliased block queries with blocks of height [0,total),
+// the first request including the height failOnce will fail
+func newBlockRangeTestServer(t *testing.T, total int, failOnce int) (*Client, func() []string) {
+	var lock sync.Mutex
+	var queries []string
+	failed := false
+	blockPattern := regexp.MustCompile(`(b\d+):block\(height: "(\d+)" \)`)
+	srv := newTestServer(t, func(q string) any {
+		lock.Lock()
+		defer lock.Unlock()
+		queries = append(queries, q)
+		data := make(map[string]any)
+		for _, m := range blockPattern.FindAllStringSubmatch(q, -1) {
+			height, _ := strconv.Atoi(m[2])
+			if height == failOnce && !failed {
+				failed = true
+				return nil
+			}
+			if height < total {
+				data[m[1]] = map[string]any{"height": m[2]}
+			} else {
+				data[m[1]] = nil
+			}
+		}
+		return data
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	return cli, func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]string(nil), queries...)
+	}
+}
+
+func readBlockRange(r *BlockRange) []types.U32 {
+	var heights []types.U32
+	for r.Next() {
+		heights = append(heights, r.Block().Height)
+	}
+	return heights
+}
+
+func Test_FetchBlockRange(t *testing.T) {
+	cli, queries := newBlockRangeTestServer(t, 100, -1)
+	ctx := context.Background()
+
+	var progress []FetchProgress
+	r := cli.FetchBlockRange(ctx, 3, 24, FetchBlockRangeOption{
+		ChunkSize:   5,
+		Concurrency: 3,
+		OnProgress: func(p FetchProgress) {
+			progress = append(progress, p)
+		},
+	})
+	heights := readBlockRange(r)
+	assert.NoError(t, r.Err())
+	var expected []types.U32
+	for h := types.U32(3); h <= 24; h++ {
+		expected = append(expected, h)
+	}
+	assert.Equal(t, expected, heights)
+	assert.Len(t, queries(), 5)
+	assert.Len(t, progress, 5)
+	assert.Equal(t, types.U32(7), progress[0].Height)
+	assert.Equal(t, 5, progress[0].Blocks)
+	assert.Equal(t, types.U32(24), progress[4].Height)
+	assert.Equal(t, 22, progress[4].Blocks)
+	assert.Greater(t, progress[4].BlocksPerSecond, float64(0))
+
+	r = cli.FetchBlockRange(ctx, 95, 104, FetchBlockRangeOption{ChunkSize: 5})
+	heights = readBlockRange(r)
+	assert.Equal(t, []types.U32{95, 96, 97, 98, 99}, heights)
+	assert.EqualError(t, r.Err(), "fetch blocks [100, 104] failed: block 100 not found")
+}
+
+func Test_FetchBlockRangeRetry(t *testing.T) {
+	ctx := context.Background()
+
+	cli, queries := newBlockRangeTestServer(t, 100, 12)
+	r := cli.FetchBlockRange(ctx, 0, 19, FetchBlockRangeOption{ChunkSize: 10, MaxRetries: 1})
+	heights := readBlockRange(r)
+	assert.NoError(t, r.Err())
+	assert.Len(t, heights, 20)
+	assert.Len(t, queries(), 3)
+
+	cli, _ = newBlockRangeTestServer(t, 100, 12)
+	r = cli.FetchBlockRange(ctx, 0, 19, FetchBlockRangeOption{ChunkSize: 10})
+	heights = readBlockRange(r)
+	assert.Len(t, heights, 10)
+	assert.EqualError(t, r.Err(), "fetch blocks [10, 19] failed: block 10 not found")
+}
+
+func Test_FetchBlockRangeUseConnection(t *testing.T) {
+	cli := newBlocksTestServer(t, 30)
+	ctx := context.Background()
+
+	r := cli.FetchBlockRange(ctx, 0, 29, FetchBlockRangeOption{ChunkSize: 8, UseConnection: true})
+	heights := readBlockRange(r)
+	assert.NoError(t, r.Err())
+	assert.Len(t, heights, 30)
+	for i, height := range heights {
+		assert.Equal(t, types.U32(i), height)
+	}
+
+	r = cli.FetchBlockRange(ctx, 25, 34, FetchBlockRangeOption{ChunkSize: 10, UseConnection: true})
+	heights = readBlockRange(r)
+	assert.Empty(t, heights)
+	assert.EqualError(t, r.Err(), "fetch blocks [25, 34] failed: block 30 not found")
+}
+
+func Test_FetchBlockRangeClose(t *testing.T) {
+	cli, _ := newBlockRangeTestServer(t, 1000, -1)
+	r := cli.FetchBlockRange(context.Background(), 0, 999, FetchBlockRangeOption{ChunkSize: 10})
+	assert.True(t, r.Next())
+	r.Close()
+	assert.False(t, r.Next())
+	assert.NoError(t, r.Err())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r = cli.FetchBlockRange(ctx, 0, 999, FetchBlockRangeOption{ChunkSize: 10})
+	assert.True(t, r.Next())
+	cancel()
+	for r.Next() {
+	}
+	assert.True(t, strings.Contains(r.Err().Error(), context.Canceled.Error()))
+}
@@ -0,0 +1,184 @@
+package fuel
+
+import (
+	"context"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/query"
+	"github.com/sentioxyz/fuel-go/types"
+	"sync"
+)
+
+// CoinSelection is the spendable coins selected for one asset
+type CoinSelection struct {
+	AssetId types.AssetId
+	// Each of them is a Coin or a MessageCoin
+	Coins []types.CoinType
+}
+
+// CoinsToSpend selects spendable coins of the owner which add up at least the target amount of each asset,
+// the result is in the same order as the targets. The excluded coins will not be selected, it can be nil.
+func (c *Client) CoinsToSpend(
+	ctx context.Context,
+	owner types.Address,
+	targets []types.SpendQueryElementInput,
+	exclude *types.ExcludeInput,
+) ([]CoinSelection, error) {
+	if exclude != nil {
+		// both of the lists are required by the node
+		exclude = &types.ExcludeInput{
+			Utxos:    append([]types.UtxoId{}, exclude.Utxos...),
+			Messages: append([]types.Nonce{}, exclude.Messages...),
+		}
+	}
+	q := fmt.Sprintf("{ coinsToSpend(%s) { %s} }",
+		query.Simple.GenParam(types.QueryCoinsToSpendParams{
+			Owner:         owner,
+			QueryPerAsset: targets,
+			ExcludedIds:   exclude,
+		}),
+		query.Simple.GenObjectQuery(types.CoinType{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		CoinsToSpend [][]types.CoinType `json:"coinsToSpend"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.CoinsToSpend) != len(targets) {
+		return nil, fmt.Errorf("coinsToSpend returned %d selections for %d assets", len(result.CoinsToSpend), len(targets))
+	}
+	selections := make([]CoinSelection, len(targets))
+	for i, target := range targets {
+		selections[i] = CoinSelection{AssetId: target.AssetId, Coins: result.CoinsToSpend[i]}
+	}
+	return selections, nil
+}
+
+// MaxCoinSelectionAttempts is the max times CoinReserver.CoinsToSpend selects the coins,
+// when the selected coins are reserved by others during the query
+const MaxCoinSelectionAttempts = 10
+
+// CoinReserver tracks the coins reserved by the in-flight transactions,
+// so that concurrent senders sharing it will not select the same coins
+type CoinReserver struct {
+	cli *Client
+
+	// only guards the reserved coins, the lock is not held during the query
+	mu       sync.Mutex
+	utxos    map[string]types.UtxoId
+	messages map[types.Nonce]bool
+}
+
+func NewCoinReserver(cli *Client) *CoinReserver {
+	return &CoinReserver{
+		cli:      cli,
+		utxos:    make(map[string]types.UtxoId),
+		messages: make(map[types.Nonce]bool),
+	}
+}
+
+// CoinReservation holds the selected coins until it is released
+type CoinReservation struct {
+	Selections []CoinSelection
+
+	reserver *CoinReserver
+	released bool
+}
+
+func (r *CoinReserver) each(selections []CoinSelection, fn func(coin types.CoinType)) {
+	for _, selection := range selections {
+		for _, coin := range selection.Coins {
+			fn(coin)
+		}
+	}
+}
+
+// CoinsToSpend selects the coins like Client.CoinsToSpend, but excludes the reserved coins,
+// and the selected coins will be reserved until the returned reservation is released
+func (r *CoinReserver) CoinsToSpend(
+	ctx context.Context,
+	owner types.Address,
+	targets []types.SpendQueryElementInput,
+	exclude *types.ExcludeInput,
+) (*CoinReservation, error) {
+	for attempt := 0; attempt < MaxCoinSelectionAttempts; attempt++ {
+		selections, err := r.cli.CoinsToSpend(ctx, owner, targets, r.exclusions(exclude))
+		if err != nil {
+			return nil, err
+		}
+		if r.reserve(selections) {
+			return &CoinReservation{Selections: selections, reserver: r}, nil
+		}
+		// some of the selected coins are reserved by others during the query, select again with them excluded
+	}
+	return nil, fmt.Errorf("coin selection kept conflicting with the coins reserved by others after %d attempts",
+		MaxCoinSelectionAttempts)
+}
+
+// exclusions returns the snapshot of the reserved coins merged with the excluded coins
+func (r *CoinReserver) exclusions(exclude *types.ExcludeInput) *types.ExcludeInput {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	merged := &types.ExcludeInput{}
+	if exclude != nil {
+		merged.Utxos = append(merged.Utxos, exclude.Utxos...)
+		merged.Messages = append(merged.Messages, exclude.Messages...)
+	}
+	for _, utxo := range r.utxos {
+		merged.Utxos = append(merged.Utxos, utxo)
+	}
+	for nonce := range r.messages {
+		merged.Messages = append(merged.Messages, nonce)
+	}
+	return merged
+}
+
+// reserve reserves all the selected coins, returns false without reserving any of them
+// if some of them are already reserved
+func (r *CoinReserver) reserve(selections []CoinSelection) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	conflict := false
+	r.each(selections, func(coin types.CoinType) {
+		if coin.Coin != nil {
+			if _, has := r.utxos[coin.Coin.UtxoId.String()]; has {
+				conflict = true
+			}
+		}
+		if coin.MessageCoin != nil && r.messages[coin.MessageCoin.Nonce] {
+			conflict = true
+		}
+	})
+	if conflict {
+		return false
+	}
+	r.each(selections, func(coin types.CoinType) {
+		if coin.Coin != nil {
+			r.utxos[coin.Coin.UtxoId.String()] = coin.Coin.UtxoId
+		}
+		if coin.MessageCoin != nil {
+			r.messages[coin.MessageCoin.Nonce] = true
+		}
+	})
+	return true
+}
+
+// Release makes the coins selectable again, should be called after the transaction
+// spending them is finished or abandoned. It is safe to be called multiple times.
+func (r *CoinReservation) Release() {
+	r.reserver.mu.Lock()
+	defer r.reserver.mu.Unlock()
+	if r.released {
+		return
+	}
+	r.released = true
+	r.reserver.each(r.Selections, func(coin types.CoinType) {
+		if coin.Coin != nil {
+			delete(r.reserver.utxos, coin.Coin.UtxoId.String())
+		}
+		if coin.MessageCoin != nil {
+			delete(r.reserver.messages, coin.MessageCoin.Nonce)
+		}
+	})
+}
@@ -0,0 +1,195 @@
+package fuel
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// newCoinsToSpendTestServer serves coinsToSpend with the first candidate not excluded for each asset
+func newCoinsToSpendTestServer(t *testing.T) *Client {
+	asCoinType := func(coin map[string]any) map[string]any {
+		coin["__typename"] = "Coin"
+		return coin
+	}
+	candidates := map[string][]map[string]any{
+		testAsset1.String(): {
+			asCoinType(testCoin("0x0100", testAsset1, "5")),
+			{"__typename": "MessageCoin", "sender": testOwner.String(), "recipient": testOwner.String(),
+				"nonce": "0x01", "amount": "10", "assetId": testAsset1.String(), "daHeight": "1"},
+			asCoinType(testCoin("0x0200", testAsset1, "5")),
+		},
+		testAsset2.String(): {
+			asCoinType(testCoin("0x0300", testAsset2, "7")),
+		},
+	}
+	assetPattern := regexp.MustCompile(`assetId: "(0x[0-9a-f]+)"`)
+	srv := newTestServer(t, func(q string) any {
+		_, excluded, _ := strings.Cut(q, "excludedIds:")
+		var result []any
+		for _, asset := range assetPattern.FindAllStringSubmatch(q, -1) {
+			selected := []any{}
+			for _, coin := range candidates[asset[1]] {
+				id, is := coin["utxoId"].(string)
+				if !is {
+					id = coin["nonce"].(string)
+				}
+				if !strings.Contains(excluded, `"`+id+`"`) {
+					selected = append(selected, coin)
+					break
+				}
+			}
+			result = append(result, selected)
+		}
+		return map[string]any{"coinsToSpend": result}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	return cli
+}
+
+func Test_CoinsToSpend(t *testing.T) {
+	cli := newCoinsToSpendTestServer(t)
+	ctx := context.Background()
+	targets := []types.SpendQueryElementInput{
+		{AssetId: testAsset2, Amount: 1},
+		{AssetId: testAsset1, Amount: 1},
+	}
+
+	selections, err := cli.CoinsToSpend(ctx, testOwner, targets, nil)
+	assert.NoError(t, err)
+	assert.Len(t, selections, 2)
+	assert.Equal(t, testAsset2, selections[0].AssetId)
+	assert.Equal(t, types.U64(7), selections[0].Coins[0].Coin.Amount)
+	assert.Equal(t, testAsset1, selections[1].AssetId)
+	assert.Equal(t, "0x0100", selections[1].Coins[0].Coin.UtxoId.String())
+
+	selections, err = cli.CoinsToSpend(ctx, testOwner, targets[1:], &types.ExcludeInput{
+		Utxos: []types.UtxoId{{Bytes: []byte{1, 0}}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, types.Nonce("0x01"), selections[0].Coins[0].MessageCoin.Nonce)
+
+	reserver := NewCoinReserver(cli)
+	r1, err := reserver.CoinsToSpend(ctx, testOwner, targets[1:], nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x0100", r1.Selections[0].Coins[0].Coin.UtxoId.String())
+	r2, err := reserver.CoinsToSpend(ctx, testOwner, targets[1:], nil)
+	assert.NoError(t, err)
+	assert.Equal(t, types.Nonce("0x01"), r2.Selections[0].Coins[0].MessageCoin.Nonce)
+	r3, err := reserver.CoinsToSpend(ctx, testOwner, targets[1:], nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x0200", r3.Selections[0].Coins[0].Coin.UtxoId.String())
+
+	r2.Release()
+	r2.Release()
+	r4, err := reserver.CoinsToSpend(ctx, testOwner, targets[1:], nil)
+	assert.NoError(t, err)
+	assert.Equal(t, types.Nonce("0x01"), r4.Selections[0].Coins[0].MessageCoin.Nonce)
+
+	r1.Release()
+	r3.Release()
+	r4.Release()
+	var wg sync.WaitGroup
+	reservations := make([]*CoinReservation, 3)
+	for i := range reservations {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var err error
+			reservations[i], err = reserver.CoinsToSpend(ctx, testOwner, targets[1:], nil)
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+	selected := make(map[string]bool)
+	for _, r := range reservations {
+		coin := r.Selections[0].Coins[0]
+		if coin.Coin != nil {
+			selected[coin.Coin.UtxoId.String()] = true
+		} else {
+			selected[string(coin.MessageCoin.Nonce)] = true
+		}
+	}
+	assert.Len(t, selected, 3)
+}
+
+func Test_CoinReserverSlowQuery(t *testing.T) {
+	slow := types.Address{Hash: common.HexToHash("0x02")}
+	coin := testCoin("0x0100", testAsset1, "5")
+	coin["__typename"] = "Coin"
+	queried, unblock := make(chan struct{}), make(chan struct{})
+	var block sync.Once
+	srv := newTestServer(t, func(q string) any {
+		if strings.Contains(q, slow.String()) {
+			block.Do(func() {
+				close(queried)
+				<-unblock
+			})
+		}
+		if strings.Contains(q, `"0x0100"`) {
+			return map[string]any{"coinsToSpend": []any{[]any{}}}
+		}
+		return map[string]any{"coinsToSpend": []any{[]any{coin}}}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	ctx := context.Background()
+	targets := []types.SpendQueryElementInput{{AssetId: testAsset1, Amount: 1}}
+	reserver := NewCoinReserver(cli)
+
+	slowDone := make(chan *CoinReservation)
+	go func() {
+		r, err := reserver.CoinsToSpend(ctx, slow, targets, nil)
+		assert.NoError(t, err)
+		slowDone <- r
+	}()
+	<-queried
+
+	// the slow query blocks neither the other senders nor the releasing
+	r1, err := reserver.CoinsToSpend(ctx, testOwner, targets, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x0100", r1.Selections[0].Coins[0].Coin.UtxoId.String())
+	r2, err := reserver.CoinsToSpend(ctx, testOwner, targets, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, r2.Selections[0].Coins)
+	r2.Release()
+
+	// the slow query selected the coin reserved in the meantime, so it selects again with the coin excluded
+	close(unblock)
+	r3 := <-slowDone
+	assert.Empty(t, r3.Selections[0].Coins)
+
+	r1.Release()
+	r4, err := reserver.CoinsToSpend(ctx, testOwner, targets, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x0100", r4.Selections[0].Coins[0].Coin.UtxoId.String())
+}
+
+func Test_CoinReserverConflict(t *testing.T) {
+	coin := testCoin("0x0100", testAsset1, "5")
+	coin["__typename"] = "Coin"
+	var queries atomic.Int32
+	// the node keeps selecting the same coin, regardless of the excluded coins
+	srv := newTestServer(t, func(string) any {
+		queries.Add(1)
+		return map[string]any{"coinsToSpend": []any{[]any{coin}}}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	ctx := context.Background()
+	targets := []types.SpendQueryElementInput{{AssetId: testAsset1, Amount: 1}}
+	reserver := NewCoinReserver(cli)
+
+	_, err := reserver.CoinsToSpend(ctx, testOwner, targets, nil)
+	assert.NoError(t, err)
+	_, err = reserver.CoinsToSpend(ctx, testOwner, targets, nil)
+	assert.EqualError(t, err, "coin selection kept conflicting with the coins reserved by others after 10 attempts")
+	assert.Equal(t, int32(1+MaxCoinSelectionAttempts), queries.Load())
+}
@@ -0,0 +1,97 @@
+package fuel
+
+import (
+	"context"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/query"
+	"github.com/sentioxyz/fuel-go/types"
+	"sync"
+)
+
+// versionCache caches the data identified by versions or roots, which will never change
+type versionCache struct {
+	// types.Int => types.ConsensusParameters
+	consensusParameters sync.Map
+	// types.Int => types.StateTransitionBytecode
+	bytecodeByVersion sync.Map
+	// string of the root => types.StateTransitionBytecode
+	bytecodeByRoot sync.Map
+}
+
+// GetConsensusParameters returns the consensus parameters of the version, the result is cached permanently
+func (c *Client) GetConsensusParameters(ctx context.Context, version types.Int) (types.ConsensusParameters, error) {
+	if cached, has := c.versionCache.consensusParameters.Load(version); has {
+		return cached.(types.ConsensusParameters), nil
+	}
+	q := fmt.Sprintf("{ consensusParameters(%s) { %s} }",
+		query.Simple.GenParam(types.QueryConsensusParametersParams{Version: version}),
+		query.Simple.GenObjectQuery(types.ConsensusParameters{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		ConsensusParameters types.ConsensusParameters `json:"consensusParameters"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return types.ConsensusParameters{}, err
+	}
+	c.versionCache.consensusParameters.Store(version, result.ConsensusParameters)
+	return result.ConsensusParameters, nil
+}
+
+// ParamsForBlock returns the consensus parameters used to create the block
+func (c *Client) ParamsForBlock(ctx context.Context, header types.Header) (types.ConsensusParameters, error) {
+	return c.GetConsensusParameters(ctx, types.Int(header.ConsensusParametersVersion))
+}
+
+// GetStateTransitionBytecodeByVersion returns nil if the version does not exist,
+// the result is cached permanently if the upload of the bytecode is completed
+func (c *Client) GetStateTransitionBytecodeByVersion(
+	ctx context.Context,
+	version types.Int,
+) (*types.StateTransitionBytecode, error) {
+	if cached, has := c.versionCache.bytecodeByVersion.Load(version); has {
+		bytecode := cached.(types.StateTransitionBytecode)
+		return &bytecode, nil
+	}
+	q := fmt.Sprintf("{ stateTransitionBytecodeByVersion(%s) { %s} }",
+		query.Simple.GenParam(types.QueryStateTransitionBytecodeByVersionParams{Version: version}),
+		query.Simple.GenObjectQuery(types.StateTransitionBytecode{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		StateTransitionBytecodeByVersion *types.StateTransitionBytecode `json:"stateTransitionBytecodeByVersion"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	if bytecode := result.StateTransitionBytecodeByVersion; bytecode != nil && bytecode.Bytecode.Completed {
+		c.versionCache.bytecodeByVersion.Store(version, *bytecode)
+	}
+	return result.StateTransitionBytecodeByVersion, nil
+}
+
+// GetStateTransitionBytecodeByRoot returns the bytecode with the merkle root,
+// the result is cached permanently if the upload of the bytecode is completed
+func (c *Client) GetStateTransitionBytecodeByRoot(
+	ctx context.Context,
+	root types.HexString,
+) (types.StateTransitionBytecode, error) {
+	if cached, has := c.versionCache.bytecodeByRoot.Load(root.String()); has {
+		return cached.(types.StateTransitionBytecode), nil
+	}
+	q := fmt.Sprintf("{ stateTransitionBytecodeByRoot(%s) { %s} }",
+		query.Simple.GenParam(types.QueryStateTransitionBytecodeByRootParams{Root: root}),
+		query.Simple.GenObjectQuery(types.StateTransitionBytecode{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		StateTransitionBytecodeByRoot types.StateTransitionBytecode `json:"stateTransitionBytecodeByRoot"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return types.StateTransitionBytecode{}, err
+	}
+	if result.StateTransitionBytecodeByRoot.Bytecode.Completed {
+		c.versionCache.bytecodeByRoot.Store(root.String(), result.StateTransitionBytecodeByRoot)
+	}
+	return result.StateTransitionBytecodeByRoot, nil
+}
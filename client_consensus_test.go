@@ -0,0 +1,99 @@
+package fuel
+
+import (
+	"context"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/sentioxyz/fuel-go/util"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func Test_GetConsensusParameters(t *testing.T) {
+	var queries []string
+	srv := newTestServer(t, func(q string) any {
+		queries = append(queries, q)
+		return map[string]any{
+			"consensusParameters": map[string]any{
+				"version":           "V1",
+				"blockGasLimit":     "30000000",
+				"privilegedAddress": testOwner.String(),
+			},
+		}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	ctx := context.Background()
+
+	params, err := cli.GetConsensusParameters(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, types.U64(30000000), params.BlockGasLimit)
+	assert.Len(t, queries, 1)
+	assert.True(t, strings.HasPrefix(queries[0], `{ consensusParameters(version: 1 ) {`))
+
+	cached, err := cli.ParamsForBlock(ctx, types.Header{ConsensusParametersVersion: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, params, cached)
+	assert.Len(t, queries, 1)
+
+	_, err = cli.ParamsForBlock(ctx, types.Header{ConsensusParametersVersion: 2})
+	assert.NoError(t, err)
+	assert.Len(t, queries, 2)
+}
+
+func Test_GetStateTransitionBytecode(t *testing.T) {
+	var queries []string
+	completed := false
+	srv := newTestServer(t, func(q string) any {
+		queries = append(queries, q)
+		bytecode := map[string]any{
+			"root": "0x0102",
+			"bytecode": map[string]any{
+				"bytecode":                  "0x0304",
+				"uploadedSubsectionsNumber": 1,
+				"completed":                 completed,
+			},
+		}
+		switch {
+		case strings.Contains(q, "version: 9"):
+			return map[string]any{"stateTransitionBytecodeByVersion": nil}
+		case strings.Contains(q, "stateTransitionBytecodeByVersion"):
+			return map[string]any{"stateTransitionBytecodeByVersion": bytecode}
+		default:
+			return map[string]any{"stateTransitionBytecodeByRoot": bytecode}
+		}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	ctx := context.Background()
+
+	missing, err := cli.GetStateTransitionBytecodeByVersion(ctx, 9)
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+
+	// incomplete upload is not cached
+	bytecode, err := cli.GetStateTransitionBytecodeByVersion(ctx, 1)
+	assert.NoError(t, err)
+	assert.False(t, bool(bytecode.Bytecode.Completed))
+	assert.Equal(t, util.GetPointer[types.Int](1), bytecode.Bytecode.UploadedSubsectionsNumber)
+	_, err = cli.GetStateTransitionBytecodeByVersion(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, queries, 3)
+
+	completed = true
+	for i := 0; i < 2; i++ {
+		bytecode, err = cli.GetStateTransitionBytecodeByVersion(ctx, 1)
+		assert.NoError(t, err)
+		assert.True(t, bool(bytecode.Bytecode.Completed))
+	}
+	assert.Len(t, queries, 4)
+
+	root := types.HexString{Bytes: []byte{1, 2}}
+	for i := 0; i < 2; i++ {
+		byRoot, err := cli.GetStateTransitionBytecodeByRoot(ctx, root)
+		assert.NoError(t, err)
+		assert.Equal(t, *bytecode, byRoot)
+	}
+	assert.Len(t, queries, 5)
+	assert.True(t, strings.HasPrefix(queries[4], `{ stateTransitionBytecodeByRoot(root: "0x0102" ) {`))
+}
@@ -0,0 +1,99 @@
+package fuel
+
+import (
+	"context"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/query"
+	"github.com/sentioxyz/fuel-go/types"
+	"strings"
+)
+
+type GetContractOption struct {
+	WithContractBytecode bool
+	WithContractSalt     bool
+}
+
+func (o GetContractOption) BuildIgnoreChecker() query.IgnoreChecker {
+	var checkers []query.IgnoreChecker
+	if !o.WithContractBytecode {
+		checkers = append(checkers, query.IgnoreField(types.Contract{}, "Bytecode"))
+	}
+	if !o.WithContractSalt {
+		checkers = append(checkers, query.IgnoreField(types.Contract{}, "Salt"))
+	}
+	return query.MergeIgnores(checkers...)
+}
+
+func (c *Client) GetContract(
+	ctx context.Context,
+	param types.QueryContractParams,
+	opt GetContractOption,
+) (*types.Contract, error) {
+	q := fmt.Sprintf("{ contract(%s) { %s} }",
+		query.Simple.GenParam(param),
+		query.Simple.GenObjectQuery(types.Contract{}, opt.BuildIgnoreChecker()),
+	)
+	type resultType struct {
+		Contract *types.Contract `json:"contract"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	return result.Contract, nil
+}
+
+func (c *Client) GetContracts(
+	ctx context.Context,
+	params []types.QueryContractParams,
+	opt GetContractOption,
+) ([]*types.Contract, error) {
+	cqs := make([]string, len(params))
+	selection := query.Simple.GenObjectQuery(types.Contract{}, opt.BuildIgnoreChecker())
+	for i, param := range params {
+		cqs[i] = fmt.Sprintf("c%d:contract(%s) { %s}", i, query.Simple.GenParam(param), selection)
+	}
+	q := "{" + strings.Join(cqs, " ") + " }"
+	type resultType map[string]*types.Contract
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	contracts := make([]*types.Contract, len(params))
+	for i := range params {
+		contracts[i] = result[fmt.Sprintf("c%d", i)]
+	}
+	return contracts, nil
+}
+
+func (c *Client) GetContractBalance(
+	ctx context.Context,
+	param types.QueryContractBalanceParams,
+) (types.ContractBalance, error) {
+	q := fmt.Sprintf("{ contractBalance(%s) { %s} }",
+		query.Simple.GenParam(param),
+		query.Simple.GenObjectQuery(types.ContractBalance{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		ContractBalance types.ContractBalance `json:"contractBalance"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return types.ContractBalance{}, err
+	}
+	return result.ContractBalance, nil
+}
+
+// ContractBalancesPaginator returns a paginator of the balances of all the assets of the contract
+func (c *Client) ContractBalancesPaginator(
+	contract types.ContractId,
+	opt PaginatorOption,
+) *Paginator[types.ContractBalance] {
+	params := types.QueryContractBalancesParams{Filter: types.ContractBalanceFilterInput{Contract: contract}}
+	return NewPaginator[types.ContractBalance](c, "contractBalances", params, query.IgnoreChecker{}, opt)
+}
+
+// GetContractBalances returns the balances of all the assets of the contract, all the pages will be fetched
+func (c *Client) GetContractBalances(ctx context.Context, contract types.ContractId) ([]types.ContractBalance, error) {
+	return c.ContractBalancesPaginator(contract, PaginatorOption{}).All(ctx)
+}
@@ -0,0 +1,74 @@
+package fuel
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+var testContract = types.ContractId{Hash: common.HexToHash("0xcc")}
+
+func Test_GetContract(t *testing.T) {
+	var queries []string
+	srv := newTestServer(t, func(q string) any {
+		queries = append(queries, q)
+		contract := map[string]any{"id": testContract.String(), "bytecode": "0x0102", "salt": "0x03"}
+		if strings.Contains(q, "c1:contract") {
+			return map[string]any{"c0": contract, "c1": nil}
+		}
+		return map[string]any{"contract": contract}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	ctx := context.Background()
+
+	contract, err := cli.GetContract(ctx, types.QueryContractParams{Id: testContract}, GetContractOption{})
+	assert.NoError(t, err)
+	assert.Equal(t, `{ contract(id: "`+testContract.String()+`" ) { id } }`, queries[0])
+	assert.Equal(t, testContract, contract.Id)
+
+	contract, err = cli.GetContract(ctx, types.QueryContractParams{Id: testContract}, GetContractOption{
+		WithContractBytecode: true,
+		WithContractSalt:     true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 2}, []byte(contract.Bytecode.Bytes))
+	assert.Equal(t, types.Salt("0x03"), contract.Salt)
+
+	contracts, err := cli.GetContracts(ctx, []types.QueryContractParams{
+		{Id: testContract},
+		{Id: types.ContractId{Hash: common.HexToHash("0xdd")}},
+	}, GetContractOption{WithContractSalt: true})
+	assert.NoError(t, err)
+	assert.Len(t, contracts, 2)
+	assert.Equal(t, testContract, contracts[0].Id)
+	assert.Nil(t, contracts[1])
+}
+
+func Test_GetContractBalances(t *testing.T) {
+	srv := newTestServer(t, func(q string) any {
+		balance := map[string]any{"contract": testContract.String(), "amount": "10", "assetId": testAsset1.String()}
+		if strings.Contains(q, "contractBalances(") {
+			return map[string]any{"contractBalances": map[string]any{
+				"pageInfo": map[string]any{"hasPreviousPage": false, "hasNextPage": false, "endCursor": "c"},
+				"edges":    []any{map[string]any{"cursor": "c", "node": balance}},
+			}}
+		}
+		return map[string]any{"contractBalance": balance}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	ctx := context.Background()
+
+	expected := types.ContractBalance{Contract: testContract, Amount: 10, AssetId: testAsset1}
+	balance, err := cli.GetContractBalance(ctx, types.QueryContractBalanceParams{Contract: testContract, Asset: testAsset1})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, balance)
+
+	balances, err := cli.GetContractBalances(ctx, testContract)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.ContractBalance{expected}, balances)
+}
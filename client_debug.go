@@ -0,0 +1,210 @@
+package fuel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/sentioxyz/fuel-go/query"
+	"github.com/sentioxyz/fuel-go/types"
+	"strings"
+)
+
+// VMRegisterCount is the number of the registers of the fuel VM
+const VMRegisterCount = 64
+
+// DebugSession is a VM debugger session on top of the most recent node state,
+// Close should be called to end the session, or use WithDebugSession which will do it automatically
+type DebugSession struct {
+	cli    *Client
+	id     types.ID
+	closed bool
+}
+
+func (c *Client) StartDebugSession(ctx context.Context) (*DebugSession, error) {
+	type resultType struct {
+		StartSession types.ID `json:"startSession"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, "mutation { startSession }")
+	if err != nil {
+		return nil, err
+	}
+	return &DebugSession{cli: c, id: result.StartSession}, nil
+}
+
+// WithDebugSession starts a debug session and calls fn with it, the session is always ended after fn returns,
+// even if the ctx is canceled or fn panics
+func (c *Client) WithDebugSession(ctx context.Context, fn func(session *DebugSession) error) (err error) {
+	var session *DebugSession
+	if session, err = c.StartDebugSession(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		closeErr := session.Close(context.WithoutCancel(ctx))
+		if err == nil {
+			err = closeErr
+		}
+	}()
+	return fn(session)
+}
+
+func (s *DebugSession) ID() types.ID {
+	return s.id
+}
+
+func (s *DebugSession) checkOpen() error {
+	if s.closed {
+		return fmt.Errorf("debug session %s is closed", s.id)
+	}
+	return nil
+}
+
+// mutate executes the mutation which returns a Boolean to tell if the operation succeeded
+func (s *DebugSession) mutate(ctx context.Context, field string, param any) error {
+	if err := s.checkOpen(); err != nil {
+		return err
+	}
+	q := fmt.Sprintf("mutation { %s(%s) }", field, query.Simple.GenParam(param))
+	result, err := ExecuteQuery[map[string]types.Boolean](ctx, s.cli, q)
+	if err != nil {
+		return err
+	}
+	if !result[field] {
+		return fmt.Errorf("%s in debug session %s failed", field, s.id)
+	}
+	return nil
+}
+
+// Close ends the session, it is safe to be called multiple times
+func (s *DebugSession) Close(ctx context.Context) error {
+	if s.closed {
+		return nil
+	}
+	if err := s.mutate(ctx, "endSession", types.MutationEndSessionParams{Id: s.id}); err != nil {
+		return err
+	}
+	s.closed = true
+	return nil
+}
+
+// Reset resets the VM instance to the initial state
+func (s *DebugSession) Reset(ctx context.Context) error {
+	return s.mutate(ctx, "reset", types.MutationResetParams{Id: s.id})
+}
+
+// Execute executes a single fuel-asm instruction
+func (s *DebugSession) Execute(ctx context.Context, op string) error {
+	return s.mutate(ctx, "execute", types.MutationExecuteParams{Id: s.id, Op: types.String(op)})
+}
+
+func (s *DebugSession) SetSingleStepping(ctx context.Context, enable bool) error {
+	return s.mutate(ctx, "setSingleStepping", types.MutationSetSingleSteppingParams{
+		Id:     s.id,
+		Enable: types.Boolean(enable),
+	})
+}
+
+func (s *DebugSession) SetBreakpoint(ctx context.Context, breakpoint types.Breakpoint) error {
+	return s.mutate(ctx, "setBreakpoint", types.MutationSetBreakpointParams{Id: s.id, Breakpoint: breakpoint})
+}
+
+func (s *DebugSession) run(ctx context.Context, field string, param any) (types.RunResult, error) {
+	if err := s.checkOpen(); err != nil {
+		return types.RunResult{}, err
+	}
+	q := fmt.Sprintf("mutation { %s(%s) { %s} }",
+		field,
+		query.Simple.GenParam(param),
+		query.Simple.GenObjectQuery(types.RunResult{}, query.IgnoreChecker{}),
+	)
+	result, err := ExecuteQuery[map[string]types.RunResult](ctx, s.cli, q)
+	if err != nil {
+		return types.RunResult{}, err
+	}
+	return result[field], nil
+}
+
+// StartTx runs the transaction in JSON format until it hits a breakpoint or completes
+func (s *DebugSession) StartTx(ctx context.Context, txJSON string) (types.RunResult, error) {
+	return s.run(ctx, "startTx", types.MutationStartTxParams{Id: s.id, TxJson: types.String(txJSON)})
+}
+
+// ContinueTx resumes the execution after a breakpoint, runs until the next breakpoint or the transaction completes
+func (s *DebugSession) ContinueTx(ctx context.Context) (types.RunResult, error) {
+	return s.run(ctx, "continueTx", types.MutationContinueTxParams{Id: s.id})
+}
+
+func (s *DebugSession) Register(ctx context.Context, register types.U32) (types.U64, error) {
+	if err := s.checkOpen(); err != nil {
+		return 0, err
+	}
+	q := fmt.Sprintf("{ register(%s) }", query.Simple.GenParam(types.QueryRegisterParams{Id: s.id, Register: register}))
+	type resultType struct {
+		Register types.U64 `json:"register"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, s.cli, q)
+	if err != nil {
+		return 0, err
+	}
+	return result.Register, nil
+}
+
+// Registers dumps all the registers in one round trip
+func (s *DebugSession) Registers(ctx context.Context) ([]types.U64, error) {
+	if err := s.checkOpen(); err != nil {
+		return nil, err
+	}
+	rqs := make([]string, VMRegisterCount)
+	for i := range rqs {
+		rqs[i] = fmt.Sprintf("r%d:register(%s)", i, query.Simple.GenParam(types.QueryRegisterParams{
+			Id:       s.id,
+			Register: types.U32(i),
+		}))
+	}
+	q := "{" + strings.Join(rqs, " ") + " }"
+	result, err := ExecuteQuery[map[string]types.U64](ctx, s.cli, q)
+	if err != nil {
+		return nil, err
+	}
+	registers := make([]types.U64, VMRegisterCount)
+	for i := range registers {
+		registers[i] = result[fmt.Sprintf("r%d", i)]
+	}
+	return registers, nil
+}
+
+// Memory reads size bytes of the VM memory from start
+func (s *DebugSession) Memory(ctx context.Context, start, size types.U32) ([]byte, error) {
+	if err := s.checkOpen(); err != nil {
+		return nil, err
+	}
+	q := fmt.Sprintf("{ memory(%s) }", query.Simple.GenParam(types.QueryMemoryParams{Id: s.id, Start: start, Size: size}))
+	type resultType struct {
+		Memory string `json:"memory"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, s.cli, q)
+	if err != nil {
+		return nil, err
+	}
+	return decodeMemory(result.Memory)
+}
+
+// decodeMemory decodes the memory returned by the node, which is a JSON array of the bytes
+func decodeMemory(raw string) ([]byte, error) {
+	if strings.HasPrefix(raw, "0x") {
+		return hexutil.Decode(raw)
+	}
+	// a []byte will be decoded from a base64 string, so decode into []uint16 first
+	var values []uint16
+	if err := json.Unmarshal([]byte(raw), &values); err != nil {
+		return nil, fmt.Errorf("decode memory failed: %w", err)
+	}
+	mem := make([]byte, len(values))
+	for i, v := range values {
+		if v > 0xff {
+			return nil, fmt.Errorf("decode memory failed: invalid byte %d at %d", v, i)
+		}
+		mem[i] = byte(v)
+	}
+	return mem, nil
+}
@@ -0,0 +1,101 @@
+package fuel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func Test_DebugSession(t *testing.T) {
+	var ended []string
+	srv := newTestServer(t, func(q string) any {
+		switch {
+		case strings.Contains(q, "startSession"):
+			return map[string]any{"startSession": "s1"}
+		case strings.Contains(q, "endSession"):
+			ended = append(ended, q)
+			return map[string]any{"endSession": true}
+		case strings.Contains(q, "setBreakpoint"):
+			return map[string]any{"setBreakpoint": true}
+		case strings.Contains(q, "execute"):
+			return map[string]any{"execute": false}
+		case strings.Contains(q, "startTx"):
+			return map[string]any{"startTx": map[string]any{
+				"state":        "BREAKPOINT",
+				"breakpoint":   map[string]any{"contract": common.HexToHash("0x01").String(), "pc": "4"},
+				"jsonReceipts": []any{},
+			}}
+		case strings.Contains(q, "r63:register"):
+			result := make(map[string]any)
+			for i := 0; i < VMRegisterCount; i++ {
+				result[fmt.Sprintf("r%d", i)] = fmt.Sprintf("%d", i*2)
+			}
+			return result
+		case strings.Contains(q, "memory"):
+			return map[string]any{"memory": "[1,2,255]"}
+		}
+		return nil
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	ctx := context.Background()
+
+	err := cli.WithDebugSession(ctx, func(session *DebugSession) error {
+		assert.Equal(t, types.ID("s1"), session.ID())
+		bp := types.Breakpoint{Contract: types.ContractId{Hash: common.HexToHash("0x01")}, Pc: 4}
+		assert.NoError(t, session.SetBreakpoint(ctx, bp))
+		result, err := session.StartTx(ctx, `{"Script":{}}`)
+		assert.NoError(t, err)
+		assert.Equal(t, types.RunState("BREAKPOINT"), result.State)
+		assert.Equal(t, types.U64(4), result.Breakpoint.Pc)
+		registers, err := session.Registers(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, registers, VMRegisterCount)
+		assert.Equal(t, types.U64(126), registers[63])
+		mem, err := session.Memory(ctx, 0, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{1, 2, 255}, mem)
+		assert.EqualError(t, session.Execute(ctx, "noop"), "execute in debug session s1 failed")
+		return errors.New("stop")
+	})
+	assert.EqualError(t, err, "stop")
+	assert.Equal(t, []string{`mutation { endSession(id: "s1" ) }`}, ended)
+
+	assert.Panics(t, func() {
+		_ = cli.WithDebugSession(ctx, func(session *DebugSession) error {
+			panic("oops")
+		})
+	})
+	assert.Len(t, ended, 2)
+
+	session, err := cli.StartDebugSession(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, session.Close(ctx))
+	assert.NoError(t, session.Close(ctx))
+	assert.Len(t, ended, 3)
+	assert.EqualError(t, session.Reset(ctx), "debug session s1 is closed")
+	_, err = session.StartTx(ctx, "{}")
+	assert.EqualError(t, err, "debug session s1 is closed")
+	_, err = session.Register(ctx, 1)
+	assert.EqualError(t, err, "debug session s1 is closed")
+	_, err = session.Registers(ctx)
+	assert.EqualError(t, err, "debug session s1 is closed")
+	_, err = session.Memory(ctx, 0, 3)
+	assert.EqualError(t, err, "debug session s1 is closed")
+}
+
+func Test_decodeMemory(t *testing.T) {
+	mem, err := decodeMemory("[]")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{}, mem)
+	mem, err = decodeMemory("0x0aff")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{10, 255}, mem)
+	_, err = decodeMemory("[1,256]")
+	assert.EqualError(t, err, "decode memory failed: invalid byte 256 at 1")
+}
@@ -0,0 +1,93 @@
+package fuel
+
+import (
+	"context"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/query"
+	"github.com/sentioxyz/fuel-go/types"
+)
+
+// GetMessage returns nil if the message with the nonce does not exist
+func (c *Client) GetMessage(ctx context.Context, nonce types.Nonce) (*types.Message, error) {
+	q := fmt.Sprintf("{ message(%s) { %s} }",
+		query.Simple.GenParam(types.QueryMessageParams{Nonce: nonce}),
+		query.Simple.GenObjectQuery(types.Message{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		Message *types.Message `json:"message"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	return result.Message, nil
+}
+
+// MessagesPaginator returns a paginator of the messages of the owner, all the messages will be listed if owner is nil
+func (c *Client) MessagesPaginator(owner *types.Address, opt PaginatorOption) *Paginator[types.Message] {
+	params := types.QueryMessagesParams{Owner: owner}
+	return NewPaginator[types.Message](c, "messages", params, query.IgnoreChecker{}, opt)
+}
+
+// GetMessages returns the messages of the owner, all the pages will be fetched
+func (c *Client) GetMessages(ctx context.Context, owner types.Address) ([]types.Message, error) {
+	return c.MessagesPaginator(&owner, PaginatorOption{}).All(ctx)
+}
+
+// GetMessageProof returns nil if the proof is not available.
+// Exactly one of param.CommitBlockId and param.CommitBlockHeight should be set, as required by the node.
+func (c *Client) GetMessageProof(
+	ctx context.Context,
+	param types.QueryMessageProofParams,
+) (*types.MessageProof, error) {
+	if (param.CommitBlockId == nil) == (param.CommitBlockHeight == nil) {
+		return nil, fmt.Errorf("exactly one of commitBlockId and commitBlockHeight should be specified")
+	}
+	q := fmt.Sprintf("{ messageProof(%s) { %s} }",
+		query.Simple.GenParam(param),
+		query.Simple.GenObjectQuery(types.MessageProof{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		MessageProof *types.MessageProof `json:"messageProof"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	return result.MessageProof, nil
+}
+
+func (c *Client) GetMessageStatus(ctx context.Context, nonce types.Nonce) (types.MessageStatus, error) {
+	q := fmt.Sprintf("{ messageStatus(%s) { %s} }",
+		query.Simple.GenParam(types.QueryMessageStatusParams{Nonce: nonce}),
+		query.Simple.GenObjectQuery(types.MessageStatus{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		MessageStatus types.MessageStatus `json:"messageStatus"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return types.MessageStatus{}, err
+	}
+	return result.MessageStatus, nil
+}
+
+// GetRelayedTransactionStatus returns nil if the relayed transaction has not failed,
+// otherwise result.RelayedTransactionFailed holds the reason of the failure
+func (c *Client) GetRelayedTransactionStatus(
+	ctx context.Context,
+	id types.RelayedTransactionId,
+) (*types.RelayedTransactionStatus, error) {
+	q := fmt.Sprintf("{ relayedTransactionStatus(%s) { %s} }",
+		query.Simple.GenParam(types.QueryRelayedTransactionStatusParams{Id: id}),
+		query.Simple.GenObjectQuery(types.RelayedTransactionStatus{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		RelayedTransactionStatus *types.RelayedTransactionStatus `json:"relayedTransactionStatus"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	return result.RelayedTransactionStatus, nil
+}
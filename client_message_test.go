@@ -0,0 +1,140 @@
+package fuel
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/sentioxyz/fuel-go/util"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func testMessage(nonce string, amount string) map[string]any {
+	return map[string]any{
+		"amount":    amount,
+		"sender":    testOwner.String(),
+		"recipient": testOwner.String(),
+		"nonce":     nonce,
+		"data":      "0x",
+		"daHeight":  "7",
+	}
+}
+
+func Test_GetMessage(t *testing.T) {
+	var queries []string
+	srv := newTestServer(t, func(q string) any {
+		queries = append(queries, q)
+		switch {
+		case strings.Contains(q, "messages("):
+			return map[string]any{"messages": map[string]any{
+				"pageInfo": map[string]any{"hasPreviousPage": false, "hasNextPage": false, "endCursor": "n2"},
+				"edges": []any{
+					map[string]any{"cursor": "n1", "node": testMessage("n1", "1")},
+					map[string]any{"cursor": "n2", "node": testMessage("n2", "2")},
+				},
+			}}
+		case strings.Contains(q, "messageStatus("):
+			return map[string]any{"messageStatus": map[string]any{"state": "SPENT"}}
+		case strings.Contains(q, `nonce: "n0"`):
+			return map[string]any{"message": nil}
+		default:
+			return map[string]any{"message": testMessage("n1", "1")}
+		}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	ctx := context.Background()
+
+	message, err := cli.GetMessage(ctx, "n1")
+	assert.NoError(t, err)
+	assert.Equal(t, &types.Message{
+		Amount:    1,
+		Sender:    testOwner,
+		Recipient: testOwner,
+		Nonce:     "n1",
+		Data:      types.HexString{Bytes: []byte{}},
+		DaHeight:  7,
+	}, message)
+
+	message, err = cli.GetMessage(ctx, "n0")
+	assert.NoError(t, err)
+	assert.Nil(t, message)
+
+	messages, err := cli.GetMessages(ctx, testOwner)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+	assert.Equal(t, types.Nonce("n2"), messages[1].Nonce)
+	assert.Contains(t, queries[len(queries)-1], `owner: "`+testOwner.String()+`"`)
+
+	status, err := cli.GetMessageStatus(ctx, "n1")
+	assert.NoError(t, err)
+	assert.Equal(t, types.MessageStatus{State: "SPENT"}, status)
+}
+
+func Test_GetMessageProof(t *testing.T) {
+	var queries []string
+	srv := newTestServer(t, func(q string) any {
+		queries = append(queries, q)
+		return map[string]any{"messageProof": map[string]any{
+			"messageProof": map[string]any{"proofSet": []any{common.HexToHash("0x01").String()}, "proofIndex": "1"},
+			"blockProof":   map[string]any{"proofSet": []any{}, "proofIndex": "0"},
+			"nonce":        "n1",
+			"amount":       "5",
+		}}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	ctx := context.Background()
+
+	param := types.QueryMessageProofParams{
+		TransactionId:     types.TransactionId{Hash: common.HexToHash("0x02")},
+		Nonce:             "n1",
+		CommitBlockHeight: util.GetPointer[types.U32](10),
+	}
+	proof, err := cli.GetMessageProof(ctx, param)
+	assert.NoError(t, err)
+	assert.Equal(t, types.U64(5), proof.Amount)
+	assert.Equal(t, types.MerkleProof{
+		ProofSet:   []types.Bytes32{{Hash: common.HexToHash("0x01")}},
+		ProofIndex: 1,
+	}, proof.MessageProof)
+	assert.Contains(t, queries[0], `commitBlockHeight: "10"`)
+	assert.NotContains(t, queries[0], "commitBlockId")
+
+	param.CommitBlockId = &types.BlockId{Hash: common.HexToHash("0x03")}
+	_, err = cli.GetMessageProof(ctx, param)
+	assert.EqualError(t, err, "exactly one of commitBlockId and commitBlockHeight should be specified")
+	param.CommitBlockId, param.CommitBlockHeight = nil, nil
+	_, err = cli.GetMessageProof(ctx, param)
+	assert.EqualError(t, err, "exactly one of commitBlockId and commitBlockHeight should be specified")
+	assert.Len(t, queries, 1)
+}
+
+func Test_GetRelayedTransactionStatus(t *testing.T) {
+	failedID := types.RelayedTransactionId{Hash: common.HexToHash("0x01")}
+	srv := newTestServer(t, func(q string) any {
+		if !strings.Contains(q, failedID.String()) {
+			return map[string]any{"relayedTransactionStatus": nil}
+		}
+		return map[string]any{"relayedTransactionStatus": map[string]any{
+			"__typename":  "RelayedTransactionFailed",
+			"blockHeight": "12",
+			"failure":     "out of gas",
+		}}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	ctx := context.Background()
+
+	status, err := cli.GetRelayedTransactionStatus(ctx, failedID)
+	assert.NoError(t, err)
+	assert.Equal(t, &types.RelayedTransactionStatus{
+		TypeName_:                "RelayedTransactionFailed",
+		RelayedTransactionFailed: &types.RelayedTransactionFailed{BlockHeight: 12, Failure: "out of gas"},
+	}, status)
+
+	status, err = cli.GetRelayedTransactionStatus(ctx, types.RelayedTransactionId{Hash: common.HexToHash("0x02")})
+	assert.NoError(t, err)
+	assert.Nil(t, status)
+}
@@ -0,0 +1,120 @@
+package fuel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/query"
+	"github.com/sentioxyz/fuel-go/types"
+)
+
+type GetNodeInfoOption struct {
+	WithPeers bool
+}
+
+func (o GetNodeInfoOption) BuildIgnoreChecker() query.IgnoreChecker {
+	if !o.WithPeers {
+		return query.IgnoreField(types.NodeInfo{}, "Peers")
+	}
+	return query.IgnoreChecker{}
+}
+
+func (c *Client) GetNodeInfo(ctx context.Context, opt GetNodeInfoOption) (types.NodeInfo, error) {
+	q := fmt.Sprintf("{ nodeInfo { %s} }",
+		query.Simple.GenObjectQuery(types.NodeInfo{}, opt.BuildIgnoreChecker()),
+	)
+	type resultType struct {
+		NodeInfo types.NodeInfo `json:"nodeInfo"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return types.NodeInfo{}, err
+	}
+	return result.NodeInfo, nil
+}
+
+// GetHealth returns true when the GraphQL API of the node is serving requests
+func (c *Client) GetHealth(ctx context.Context) (bool, error) {
+	type resultType struct {
+		Health types.Boolean `json:"health"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, "{ health }")
+	if err != nil {
+		return false, err
+	}
+	return bool(result.Health), nil
+}
+
+func (c *Client) GetLatestGasPrice(ctx context.Context) (types.LatestGasPrice, error) {
+	q := fmt.Sprintf("{ latestGasPrice { %s} }",
+		query.Simple.GenObjectQuery(types.LatestGasPrice{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		LatestGasPrice types.LatestGasPrice `json:"latestGasPrice"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return types.LatestGasPrice{}, err
+	}
+	return result.LatestGasPrice, nil
+}
+
+// EstimateGasPrice estimates the gas price for the blocks of param.BlockHorizon into the future
+func (c *Client) EstimateGasPrice(
+	ctx context.Context,
+	param types.QueryEstimateGasPriceParams,
+) (types.EstimateGasPrice, error) {
+	q := fmt.Sprintf("{ estimateGasPrice(%s) { %s} }",
+		query.Simple.GenParam(param),
+		query.Simple.GenObjectQuery(types.EstimateGasPrice{}, query.IgnoreChecker{}),
+	)
+	type resultType struct {
+		EstimateGasPrice types.EstimateGasPrice `json:"estimateGasPrice"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return types.EstimateGasPrice{}, err
+	}
+	return result.EstimateGasPrice, nil
+}
+
+type GetNodeStatusOption struct {
+	GetNodeInfoOption
+	// Param of the gas price estimation
+	EstimateGasPrice types.QueryEstimateGasPriceParams
+}
+
+type NodeStatus struct {
+	Health           bool
+	NodeInfo         types.NodeInfo
+	LatestGasPrice   types.LatestGasPrice
+	EstimateGasPrice types.EstimateGasPrice
+}
+
+// GetNodeStatus fetches the health, the node info and the gas prices in one round trip
+func (c *Client) GetNodeStatus(ctx context.Context, opt GetNodeStatusOption) (status NodeStatus, err error) {
+	health := query.Root[types.Boolean]("health", nil)
+	nodeInfo := query.Root[types.NodeInfo]("nodeInfo", nil).Ignore(opt.BuildIgnoreChecker())
+	latestGasPrice := query.Root[types.LatestGasPrice]("latestGasPrice", nil)
+	estimateGasPrice := query.Root[types.EstimateGasPrice]("estimateGasPrice", opt.EstimateGasPrice)
+	q := query.Simple.GenQuery(health, nodeInfo, latestGasPrice, estimateGasPrice)
+	var result map[string]json.RawMessage
+	if result, err = ExecuteQuery[map[string]json.RawMessage](ctx, c, q); err != nil {
+		return status, err
+	}
+	var healthy types.Boolean
+	if healthy, err = health.Decode(result); err != nil {
+		return status, err
+	}
+	status.Health = bool(healthy)
+	if status.NodeInfo, err = nodeInfo.Decode(result); err != nil {
+		return status, err
+	}
+	if status.LatestGasPrice, err = latestGasPrice.Decode(result); err != nil {
+		return status, err
+	}
+	if status.EstimateGasPrice, err = estimateGasPrice.Decode(result); err != nil {
+		return status, err
+	}
+	return status, nil
+}
@@ -0,0 +1,67 @@
+package fuel
+
+import (
+	"context"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/sentioxyz/fuel-go/util"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_GetNodeStatus(t *testing.T) {
+	var queries []string
+	nodeInfo := map[string]any{
+		"utxoValidation": true,
+		"vmBacktrace":    false,
+		"maxTx":          "4064",
+		"maxDepth":       "10",
+		"nodeVersion":    "0.35.0",
+		"peers": []any{map[string]any{
+			"id":              "peer1",
+			"addresses":       []any{"/ip4/127.0.0.1/tcp/30333"},
+			"blockHeight":     "100",
+			"lastHeartbeatMs": "1717000000000",
+			"appScore":        1.5,
+		}},
+	}
+	srv := newTestServer(t, func(q string) any {
+		queries = append(queries, q)
+		return map[string]any{
+			"health":           true,
+			"nodeInfo":         nodeInfo,
+			"latestGasPrice":   map[string]any{"gasPrice": "1", "blockHeight": "100"},
+			"estimateGasPrice": map[string]any{"gasPrice": "2"},
+		}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	ctx := context.Background()
+
+	healthy, err := cli.GetHealth(ctx)
+	assert.NoError(t, err)
+	assert.True(t, healthy)
+
+	info, err := cli.GetNodeInfo(ctx, GetNodeInfoOption{})
+	assert.NoError(t, err)
+	assert.Equal(t, types.String("0.35.0"), info.NodeVersion)
+	assert.NotContains(t, queries[len(queries)-1], "peers")
+
+	latest, err := cli.GetLatestGasPrice(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, types.LatestGasPrice{GasPrice: 1, BlockHeight: 100}, latest)
+
+	estimated, err := cli.EstimateGasPrice(ctx, types.QueryEstimateGasPriceParams{BlockHorizon: util.GetPointer[types.U32](10)})
+	assert.NoError(t, err)
+	assert.Equal(t, `{ estimateGasPrice(blockHorizon: "10" ) { gasPrice } }`, queries[len(queries)-1])
+	assert.Equal(t, types.U64(2), estimated.GasPrice)
+
+	status, err := cli.GetNodeStatus(ctx, GetNodeStatusOption{GetNodeInfoOption: GetNodeInfoOption{WithPeers: true}})
+	assert.NoError(t, err)
+	assert.Len(t, queries, 5)
+	assert.True(t, status.Health)
+	assert.Equal(t, types.U64(4064), status.NodeInfo.MaxTx)
+	assert.Equal(t, types.String("peer1"), status.NodeInfo.Peers[0].Id)
+	assert.Equal(t, types.U32(100), *status.NodeInfo.Peers[0].BlockHeight)
+	assert.Equal(t, types.U64(1), status.LatestGasPrice.GasPrice)
+	assert.Equal(t, types.U64(2), status.EstimateGasPrice.GasPrice)
+}
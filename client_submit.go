@@ -0,0 +1,82 @@
+package fuel
+
+import (
+	"context"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/query"
+	"github.com/sentioxyz/fuel-go/types"
+)
+
+// SubmitTransaction submits the canonical encoded transaction to the TxPool of the node,
+// returns the transaction if it is accepted by the TxPool
+func (c *Client) SubmitTransaction(
+	ctx context.Context,
+	rawTx types.HexString,
+	opt GetTransactionOption,
+) (*types.Transaction, error) {
+	q := fmt.Sprintf("mutation { submit(%s) { %s} }",
+		query.Simple.GenParam(types.MutationSubmitParams{Tx: rawTx}),
+		query.Simple.GenObjectQuery(types.Transaction{}, opt.BuildIgnoreChecker()),
+	)
+	type resultType struct {
+		Submit *types.Transaction `json:"submit"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	return result.Submit, nil
+}
+
+type DryRunOption struct {
+	// Nil means using the default of the node
+	UtxoValidation *types.Boolean
+	// Nil means using the latest gas price
+	GasPrice *types.U64
+
+	WithReceipts     bool
+	WithProgramState bool
+}
+
+func (o DryRunOption) BuildIgnoreChecker() query.IgnoreChecker {
+	// The receipts in the status are the same as DryRunTransactionExecutionStatus.Receipts
+	ignoreCheckers := []query.IgnoreChecker{
+		query.IgnoreField(types.DryRunSuccessStatus{}, "Receipts"),
+		query.IgnoreField(types.DryRunFailureStatus{}, "Receipts"),
+	}
+	if !o.WithReceipts {
+		ignoreCheckers = append(ignoreCheckers, query.IgnoreField(types.DryRunTransactionExecutionStatus{}, "Receipts"))
+	}
+	if !o.WithProgramState {
+		ignoreCheckers = append(ignoreCheckers,
+			query.IgnoreField(types.DryRunSuccessStatus{}, "ProgramState"),
+			query.IgnoreField(types.DryRunFailureStatus{}, "ProgramState"),
+		)
+	}
+	return query.MergeIgnores(ignoreCheckers...)
+}
+
+// DryRun executes the canonical encoded transactions using a fork of current state, no changes are committed.
+// The result contains the status of each transaction, and the receipts if opt.WithReceipts is true.
+func (c *Client) DryRun(
+	ctx context.Context,
+	txs []types.HexString,
+	opt DryRunOption,
+) ([]types.DryRunTransactionExecutionStatus, error) {
+	q := fmt.Sprintf("mutation { dryRun(%s) { %s} }",
+		query.Simple.GenParam(types.MutationDryRunParams{
+			Txs:            txs,
+			UtxoValidation: opt.UtxoValidation,
+			GasPrice:       opt.GasPrice,
+		}),
+		query.Simple.GenObjectQuery(types.DryRunTransactionExecutionStatus{}, opt.BuildIgnoreChecker()),
+	)
+	type resultType struct {
+		DryRun []types.DryRunTransactionExecutionStatus `json:"dryRun"`
+	}
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	return result.DryRun, nil
+}
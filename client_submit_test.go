@@ -0,0 +1,104 @@
+package fuel
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/sentioxyz/fuel-go/util"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func Test_SubmitTransaction(t *testing.T) {
+	srv := newTestServer(t, func(q string) any {
+		assert.True(t, strings.HasPrefix(q, `mutation { submit(tx: "0x0102" ) { id `), q)
+		assert.NotContains(t, q, "receipts {")
+		return map[string]any{"submit": map[string]any{
+			"id":      common.HexToHash("0x01").String(),
+			"outputs": []any{},
+			"status":  map[string]any{"__typename": "SubmittedStatus", "time": "4611686020140536983"},
+		}}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	txn, err := cli.SubmitTransaction(context.Background(), types.HexString{Bytes: []byte{1, 2}}, GetTransactionOption{
+		WithStatus: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, types.TransactionId{Hash: common.HexToHash("0x01")}, txn.Id)
+	assert.Equal(t, "SubmittedStatus", txn.Status.TypeName_)
+	assert.NotNil(t, txn.Status.SubmittedStatus)
+}
+
+func Test_DryRun(t *testing.T) {
+	srv := newTestServer(t, func(q string) any {
+		assert.True(t, strings.HasPrefix(q, `mutation { dryRun(txs: ["0x01", "0x02"] utxoValidation: true ) { `), q)
+		assert.Contains(t, q, "receipts {")
+		assert.Contains(t, q, "programState {")
+		return map[string]any{"dryRun": []any{
+			map[string]any{
+				"id": common.HexToHash("0x01").String(),
+				"status": map[string]any{
+					"__typename": "DryRunSuccessStatus",
+					"totalGas":   "100",
+					"totalFee":   "1",
+					"programState": map[string]any{
+						"returnType": "RETURN",
+						"data":       "0x01",
+					},
+				},
+				"receipts": []any{map[string]any{"receiptType": "SCRIPT_RESULT", "result": "0", "gasUsed": "100"}},
+			},
+			map[string]any{
+				"id": common.HexToHash("0x02").String(),
+				"status": map[string]any{
+					"__typename": "DryRunFailureStatus",
+					"reason":     "Revert(0)",
+					"totalGas":   "50",
+					"totalFee":   "1",
+				},
+				"receipts": []any{map[string]any{"receiptType": "REVERT", "ra": "0"}},
+			},
+		}}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	result, err := cli.DryRun(context.Background(), []types.HexString{{Bytes: []byte{1}}, {Bytes: []byte{2}}}, DryRunOption{
+		UtxoValidation:   util.GetPointer[types.Boolean](true),
+		WithReceipts:     true,
+		WithProgramState: true,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, types.U64(100), result[0].Status.DryRunSuccessStatus.TotalGas)
+	assert.Equal(t, types.ReturnType("RETURN"), result[0].Status.DryRunSuccessStatus.ProgramState.ReturnType)
+	assert.Equal(t, types.ReceiptType("SCRIPT_RESULT"), result[0].Receipts[0].ReceiptType)
+	assert.Equal(t, types.String("Revert(0)"), result[1].Status.DryRunFailureStatus.Reason)
+	assert.Equal(t, types.ReceiptType("REVERT"), result[1].Receipts[0].ReceiptType)
+}
+
+func Test_DryRunWithoutReceipts(t *testing.T) {
+	srv := newTestServer(t, func(q string) any {
+		assert.NotContains(t, q, "receipts {")
+		assert.NotContains(t, q, "programState {")
+		return map[string]any{"dryRun": []any{
+			map[string]any{
+				"id": common.HexToHash("0x01").String(),
+				"status": map[string]any{
+					"__typename": "DryRunSuccessStatus",
+					"totalGas":   "100",
+					"totalFee":   "1",
+				},
+			},
+		}}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	result, err := cli.DryRun(context.Background(), []types.HexString{{Bytes: []byte{1}}}, DryRunOption{})
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, types.U64(100), result[0].Status.DryRunSuccessStatus.TotalGas)
+	assert.Nil(t, result[0].Status.DryRunSuccessStatus.ProgramState)
+	assert.Empty(t, result[0].Receipts)
+}
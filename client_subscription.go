@@ -0,0 +1,99 @@
+package fuel
+
+import (
+	"context"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/query"
+	"github.com/sentioxyz/fuel-go/types"
+)
+
+type SubscribeStatusOption struct {
+	WithReceipts bool
+	SubscriptionOption
+}
+
+func (o SubscribeStatusOption) BuildIgnoreChecker() query.IgnoreChecker {
+	checkers := []query.IgnoreChecker{
+		query.IgnoreField(types.SuccessStatus{}, "Block"),
+		query.IgnoreField(types.FailureStatus{}, "Block"),
+		query.IgnoreField(types.SuccessStatus{}, "Transaction"),
+		query.IgnoreField(types.FailureStatus{}, "Transaction"),
+	}
+	if !o.WithReceipts {
+		checkers = append(checkers, query.IgnoreField(types.SuccessStatus{}, "Receipts"))
+		checkers = append(checkers, query.IgnoreField(types.FailureStatus{}, "Receipts"))
+	}
+	return query.MergeIgnores(checkers...)
+}
+
+// IsFinalStatus returns whether the transaction status will not change anymore
+func IsFinalStatus(status types.TransactionStatus) bool {
+	return status.SuccessStatus != nil || status.FailureStatus != nil || status.SqueezedOutStatus != nil
+}
+
+// StatusSubscription is a subscription of the status of a transaction
+type StatusSubscription struct {
+	sub   *Subscription[map[string]types.TransactionStatus]
+	field string
+}
+
+func (s *StatusSubscription) Next() bool {
+	return s.sub.Next()
+}
+
+func (s *StatusSubscription) Status() types.TransactionStatus {
+	return s.sub.Data()[s.field]
+}
+
+func (s *StatusSubscription) Err() error {
+	return s.sub.Err()
+}
+
+func (s *StatusSubscription) Close() {
+	s.sub.Close()
+}
+
+func (c *Client) subscribeStatus(
+	ctx context.Context,
+	field string,
+	param any,
+	opt SubscribeStatusOption,
+) (*StatusSubscription, error) {
+	q := fmt.Sprintf("subscription { %s(%s) { %s} }",
+		field,
+		query.Simple.GenParam(param),
+		query.Simple.GenObjectQuery(types.TransactionStatus{}, opt.BuildIgnoreChecker()),
+	)
+	isFinal := func(data map[string]types.TransactionStatus) bool {
+		return IsFinalStatus(data[field])
+	}
+	sub, err := ExecuteSubscription(ctx, c, q, opt.SubscriptionOption, isFinal)
+	if err != nil {
+		return nil, err
+	}
+	return &StatusSubscription{sub: sub, field: field}, nil
+}
+
+// SubscribeStatusChange returns the stream of the status updates of the transaction.
+// The stream ends after a final status, if it is broken or closed by the node before that,
+// it will be reconnected at most opt.MaxReconnects times.
+func (c *Client) SubscribeStatusChange(
+	ctx context.Context,
+	txID types.TransactionId,
+	opt SubscribeStatusOption,
+) (*StatusSubscription, error) {
+	return c.subscribeStatus(ctx, "statusChange", types.SubscriptionStatusChangeParams{Id: txID}, opt)
+}
+
+// SubmitAndAwaitStatus submits the canonical encoded transaction and returns the stream of its status,
+// including the SubmittedStatus as an intermediate state.
+// The stream will never be reconnected because it will submit the transaction again,
+// use SubscribeStatusChange with the transaction id to continue if the stream is broken.
+func (c *Client) SubmitAndAwaitStatus(
+	ctx context.Context,
+	rawTx types.HexString,
+	opt SubscribeStatusOption,
+) (*StatusSubscription, error) {
+	opt.MaxReconnects = 0
+	return c.subscribeStatus(ctx, "submitAndAwaitStatus", types.SubscriptionSubmitAndAwaitStatusParams{Tx: rawTx}, opt)
+}
@@ -0,0 +1,186 @@
+package fuel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newSSETestServer starts a local subscription endpoint, the n-th connection (start from 0)
+// will receive the events returned by events(n) and be closed after them, unless hang is true
+func newSSETestServer(t *testing.T, events func(n int) (data []any, hang bool)) *Client {
+	var connections atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasSuffix(r.URL.Path, "-sub"))
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+		var req struct {
+			Query string `json:"query"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.NoError(t, ValidateQuery(req.Query))
+		w.Header().Set("content-type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		data, hang := events(int(connections.Add(1) - 1))
+		_, _ = fmt.Fprint(w, ": keep-alive\n\n")
+		for _, d := range data {
+			text, _ := json.Marshal(map[string]any{"data": d})
+			_, _ = fmt.Fprintf(w, "data: %s\n\n", text)
+			w.(http.Flusher).Flush()
+		}
+		if hang {
+			<-r.Context().Done()
+		}
+	}))
+	t.Cleanup(srv.Close)
+	cli := NewClient(srv.URL + "/v1/graphql")
+	cli.SetDebug(true)
+	return cli
+}
+
+func statusEvent(field, typeName string) any {
+	status := map[string]any{"__typename": typeName}
+	switch typeName {
+	case "SubmittedStatus":
+		status["time"] = "4611686020140536983"
+	case "SqueezedOutStatus":
+		status["reason"] = "gas price too low"
+	case "SuccessStatus":
+		status["transactionId"] = common.HexToHash("0x01").String()
+		status["blockHeight"] = "5"
+		status["time"] = "4611686020140536983"
+		status["totalGas"] = "10"
+		status["totalFee"] = "1"
+	}
+	return map[string]any{field: status}
+}
+
+func collectStatus(sub *StatusSubscription) []string {
+	var result []string
+	for sub.Next() {
+		result = append(result, sub.Status().TypeName_)
+	}
+	return result
+}
+
+func Test_SubscribeStatusChange(t *testing.T) {
+	txID := types.TransactionId{Hash: common.HexToHash("0x01")}
+	{
+		cli := newSSETestServer(t, func(int) ([]any, bool) {
+			return []any{
+				statusEvent("statusChange", "SubmittedStatus"),
+				statusEvent("statusChange", "SuccessStatus"),
+			}, false
+		})
+		sub, err := cli.SubscribeStatusChange(context.Background(), txID, SubscribeStatusOption{})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"SubmittedStatus", "SuccessStatus"}, collectStatus(sub))
+		assert.NoError(t, sub.Err())
+		assert.Equal(t, types.U32(5), sub.Status().SuccessStatus.BlockHeight)
+	}
+	{
+		// reconnect after the stream closed without the final status
+		cli := newSSETestServer(t, func(n int) ([]any, bool) {
+			if n == 0 {
+				return []any{statusEvent("statusChange", "SubmittedStatus")}, false
+			}
+			return []any{statusEvent("statusChange", "SqueezedOutStatus")}, false
+		})
+		sub, err := cli.SubscribeStatusChange(context.Background(), txID, SubscribeStatusOption{
+			SubscriptionOption: SubscriptionOption{MaxReconnects: 1},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"SubmittedStatus", "SqueezedOutStatus"}, collectStatus(sub))
+		assert.NoError(t, sub.Err())
+	}
+	{
+		// no more reconnects
+		cli := newSSETestServer(t, func(n int) ([]any, bool) {
+			return []any{statusEvent("statusChange", "SubmittedStatus")}, false
+		})
+		sub, err := cli.SubscribeStatusChange(context.Background(), txID, SubscribeStatusOption{
+			SubscriptionOption: SubscriptionOption{MaxReconnects: 2},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"SubmittedStatus", "SubmittedStatus", "SubmittedStatus"}, collectStatus(sub))
+		assert.EqualError(t, sub.Err(), "subscription stream terminated before the final data: unexpected EOF")
+	}
+	{
+		// cancel
+		cli := newSSETestServer(t, func(n int) ([]any, bool) {
+			return []any{statusEvent("statusChange", "SubmittedStatus")}, true
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		sub, err := cli.SubscribeStatusChange(ctx, txID, SubscribeStatusOption{
+			SubscriptionOption: SubscriptionOption{MaxReconnects: 10},
+		})
+		assert.NoError(t, err)
+		assert.True(t, sub.Next())
+		time.AfterFunc(time.Millisecond*10, cancel)
+		assert.False(t, sub.Next())
+		assert.ErrorIs(t, sub.Err(), context.Canceled)
+	}
+}
+
+func Test_SubmitAndAwaitStatus(t *testing.T) {
+	cli := newSSETestServer(t, func(n int) ([]any, bool) {
+		assert.Equal(t, 0, n)
+		return []any{statusEvent("submitAndAwaitStatus", "SubmittedStatus")}, false
+	})
+	sub, err := cli.SubmitAndAwaitStatus(context.Background(), types.HexString{Bytes: []byte{1}}, SubscribeStatusOption{
+		SubscriptionOption: SubscriptionOption{MaxReconnects: 3},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"SubmittedStatus"}, collectStatus(sub))
+	assert.ErrorContains(t, sub.Err(), "terminated before the final data")
+}
+
+func Test_SubscriptionReconnectFailure(t *testing.T) {
+	txID := types.TransactionId{Hash: common.HexToHash("0x01")}
+	newServer := func() *Client {
+		var connections atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var event any
+			switch connections.Add(1) - 1 {
+			case 0:
+				event = statusEvent("statusChange", "SubmittedStatus")
+			case 1:
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = fmt.Fprint(w, "service unavailable")
+				return
+			default:
+				event = statusEvent("statusChange", "SqueezedOutStatus")
+			}
+			w.Header().Set("content-type", "text/event-stream")
+			w.WriteHeader(http.StatusOK)
+			text, _ := json.Marshal(map[string]any{"data": event})
+			_, _ = fmt.Fprintf(w, "data: %s\n\n", text)
+		}))
+		t.Cleanup(srv.Close)
+		return NewClient(srv.URL + "/v1/graphql")
+	}
+
+	// the second connection fails and the third one succeeds
+	sub, err := newServer().SubscribeStatusChange(context.Background(), txID, SubscribeStatusOption{
+		SubscriptionOption: SubscriptionOption{MaxReconnects: 2, ReconnectInterval: time.Millisecond},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"SubmittedStatus", "SqueezedOutStatus"}, collectStatus(sub))
+	assert.NoError(t, sub.Err())
+
+	sub, err = newServer().SubscribeStatusChange(context.Background(), txID, SubscribeStatusOption{
+		SubscriptionOption: SubscriptionOption{MaxReconnects: 1, ReconnectInterval: time.Millisecond},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"SubmittedStatus"}, collectStatus(sub))
+	assert.EqualError(t, sub.Err(), "subscription stream terminated before the final data: "+
+		"reconnect failed: subscribe failed with status \"503 Service Unavailable\": service unavailable")
+}
@@ -475,3 +475,116 @@ func Test_marshalStructpb(t *testing.T) {
 	//assert.Equal(t, string(tt1), string(tt2))
 	assert.Equal(t, exp, txn.MarshalStructpb())
 }
+
+func Test_GenQuery(t *testing.T) {
+	block := query.Root[*types.Block]("block", types.QueryBlockParams{Height: util.GetPointer[types.U32](5)}).
+		Select("Id", "Header").
+		Ignore(query.IgnoreOtherFields(types.Header{}, "Height", "Time"))
+	chain := query.Root[types.ChainInfo]("chain", nil).Select("Name").As("c")
+	health := query.Root[types.Boolean]("health", types.QueryHealthParams{})
+	assert.Equal(t,
+		`{ block(height: "5" ) { id header { height time } } c:chain { name } health }`,
+		query.Simple.GenQuery(block, chain, health),
+	)
+	assert.Equal(t, `{
+  block(height: "5" ) {
+    id
+    header {
+      height
+      time
+    }
+  }
+  c:chain {
+    name
+  }
+  health
+}`,
+		query.Beauty.GenQuery(block, chain, health),
+	)
+}
+
+func Test_ExecuteField(t *testing.T) {
+	srv := newTestServer(t, func(q string) any {
+		assert.Equal(t, `{ block(height: "5" ) { id height } }`, q)
+		return map[string]any{"block": map[string]any{"id": common.HexToHash("0x01").String(), "height": "5"}}
+	})
+	block, err := ExecuteField(context.Background(), NewClient(srv.URL),
+		query.Root[*types.Block]("block", types.QueryBlockParams{Height: util.GetPointer[types.U32](5)}).
+			Select("Id", "Height"),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, &types.Block{Id: types.BlockId{Hash: common.HexToHash("0x01")}, Height: 5}, block)
+}
+
+func Test_GenOperation(t *testing.T) {
+	dryRun := query.Root[[]types.DryRunTransactionExecutionStatus]("dryRun", types.MutationDryRunParams{
+		Txs:            []types.HexString{{Bytes: common.FromHex("0x0102")}, {Bytes: common.FromHex("0x03")}},
+		UtxoValidation: util.GetPointer[types.Boolean](false),
+		GasPrice:       util.GetPointer[types.U64](1),
+	}).Ignore(query.IgnoreObjects(types.Receipt{}))
+	setBreakpoint := query.Root[types.Boolean]("setBreakpoint", types.MutationSetBreakpointParams{
+		Id:         "1",
+		Breakpoint: types.Breakpoint{Contract: types.ContractId{Hash: common.HexToHash("0x01")}, Pc: 4},
+	})
+	startTx := query.Root[types.RunResult]("startTx", types.MutationStartTxParams{Id: "1", TxJson: `{"Script":{}}`}).
+		Select("State")
+	q := query.Simple.GenMutation(dryRun, setBreakpoint.As("bp"), startTx)
+	assert.Equal(t,
+		`mutation { dryRun(txs: ["0x0102", "0x03"] utxoValidation: false gasPrice: "1" ) { id status { __typename ... on DryRunSuccessStatus { programState { returnType data } totalGas totalFee } ... on DryRunFailureStatus { programState { returnType data } reason totalGas totalFee } } } bp:setBreakpoint(id: "1" breakpoint: { contract: "0x0000000000000000000000000000000000000000000000000000000000000001" pc: "4" } ) startTx(id: "1" txJson: "{\"Script\":{}}" ) { state } }`,
+		q,
+	)
+	assert.NoError(t, ValidateQuery(q))
+
+	statusChange := query.Root[types.TransactionStatus]("statusChange", types.SubscriptionStatusChangeParams{
+		Id: types.TransactionId{Hash: common.HexToHash("0x01")},
+	}).Ignore(query.IgnoreObjects(types.SuccessStatus{}, types.FailureStatus{}))
+	q = query.Simple.GenSubscription(statusChange)
+	assert.Equal(t,
+		`subscription { statusChange(id: "0x0000000000000000000000000000000000000000000000000000000000000001" ) { __typename ... on SubmittedStatus { time } ... on SqueezedOutStatus { reason } } }`,
+		q,
+	)
+	assert.NoError(t, ValidateQuery(q))
+
+	coinsToSpend := query.Root[[][]types.CoinType]("coinsToSpend", types.QueryCoinsToSpendParams{
+		Owner:         types.Address{Hash: common.HexToHash("0x01")},
+		QueryPerAsset: []types.SpendQueryElementInput{{AssetId: types.AssetId{Hash: common.HexToHash("0x02")}, Amount: 10}},
+		ExcludedIds:   &types.ExcludeInput{Utxos: []types.UtxoId{}, Messages: []types.Nonce{"0x03"}},
+	})
+	blocks := query.Root[types.BlockConnection]("blocks", types.QueryBlocksParams{First: util.GetPointer[types.Int](10)}).
+		Select("PageInfo")
+	assert.NoError(t, ValidateQuery(query.Simple.GenQuery(coinsToSpend, blocks)))
+}
+
+func Test_IgnoreCheckerComparable(t *testing.T) {
+	opt := GetBlockOption{WithTransactions: true, WithTransactionDetail: true}
+	assert.True(t, opt.BuildIgnoreChecker() == opt.BuildIgnoreChecker())
+	assert.False(t, opt.BuildIgnoreChecker() == GetBlockOption{}.BuildIgnoreChecker())
+	assert.True(t,
+		query.MergeIgnores(query.IgnoreField(types.Block{}, "Header"), query.IgnoreObjects(types.Receipt{}, types.Transaction{})) ==
+			query.MergeIgnores(query.IgnoreObjects(types.Transaction{}), query.IgnoreObjects(types.Receipt{}), query.IgnoreField(types.Block{}, "Header")),
+	)
+	assert.True(t, query.IgnoreOtherFields(types.Block{}, "Id", "Height") == query.IgnoreOtherFields(types.Block{}, "Height", "Id"))
+	assert.True(t, query.MergeIgnores() == query.IgnoreChecker{})
+	assert.Equal(t,
+		query.Simple.GenObjectQuery(types.Block{}, opt.BuildIgnoreChecker()),
+		query.Simple.GenObjectQuery(&types.Block{}, opt.BuildIgnoreChecker()),
+	)
+
+	// different types with the same name
+	a := func() any {
+		type local struct {
+			X types.U64 `json:"x" kind:"SCALAR"`
+		}
+		return local{}
+	}()
+	b := func() any {
+		type local struct {
+			X types.U64 `json:"x" kind:"SCALAR"`
+			Y types.U64 `json:"y" kind:"SCALAR"`
+		}
+		return local{}
+	}()
+	assert.False(t, query.IgnoreField(a, "X") == query.IgnoreField(b, "X"))
+	assert.Equal(t, "", query.Simple.GenObjectQuery(a, query.IgnoreField(a, "X")))
+	assert.Equal(t, "y ", query.Simple.GenObjectQuery(b, query.IgnoreField(b, "X")))
+}
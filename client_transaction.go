@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/sentioxyz/fuel-go/query"
 	"github.com/sentioxyz/fuel-go/types"
+	"strings"
 )
 
 type GetTransactionOption struct {
@@ -53,3 +54,56 @@ func (c *Client) GetTransaction(
 	}
 	return result.Transaction, nil
 }
+
+// TransactionsByOwnerPaginator returns a paginator of the transactions related to the owner.
+// Use Paginator.Iterator to stream every transaction across the pages.
+func (c *Client) TransactionsByOwnerPaginator(
+	owner types.Address,
+	opt GetTransactionOption,
+	popt PaginatorOption,
+) *Paginator[types.Transaction] {
+	params := types.QueryTransactionsByOwnerParams{Owner: owner}
+	return NewPaginator[types.Transaction](c, "transactionsByOwner", params, opt.BuildIgnoreChecker(), popt)
+}
+
+// GetTransactionsByOwner returns the full history of the transactions related to the owner,
+// all the pages will be fetched, from the latest transaction if popt.Backward is true
+func (c *Client) GetTransactionsByOwner(
+	ctx context.Context,
+	owner types.Address,
+	opt GetTransactionOption,
+	popt PaginatorOption,
+) ([]types.Transaction, error) {
+	return c.TransactionsByOwnerPaginator(owner, opt, popt).All(ctx)
+}
+
+// TransactionsPaginator returns a paginator of all the transactions in the chain.
+// Use Paginator.Iterator to stream every transaction across the pages.
+func (c *Client) TransactionsPaginator(opt GetTransactionOption, popt PaginatorOption) *Paginator[types.Transaction] {
+	return NewPaginator[types.Transaction](c, "transactions", types.QueryTransactionsParams{}, opt.BuildIgnoreChecker(), popt)
+}
+
+// GetTransactions fetches all the transactions in one request, the results are in the order of params,
+// and the transaction will be nil if it does not exist
+func (c *Client) GetTransactions(
+	ctx context.Context,
+	params []types.QueryTransactionParams,
+	opt GetTransactionOption,
+) ([]*types.Transaction, error) {
+	tqs := make([]string, len(params))
+	selection := query.Simple.GenObjectQuery(types.Transaction{}, opt.BuildIgnoreChecker())
+	for i, param := range params {
+		tqs[i] = fmt.Sprintf("t%d:transaction(%s) { %s}", i, query.Simple.GenParam(param), selection)
+	}
+	q := "{" + strings.Join(tqs, " ") + " }"
+	type resultType map[string]*types.Transaction
+	result, err := ExecuteQuery[resultType](ctx, c, q)
+	if err != nil {
+		return nil, err
+	}
+	txns := make([]*types.Transaction, len(params))
+	for i := range params {
+		txns[i] = result[fmt.Sprintf("t%d", i)]
+	}
+	return txns, nil
+}
@@ -6,6 +6,10 @@ import (
 	"github.com/sentioxyz/fuel-go/types"
 	"github.com/sentioxyz/fuel-go/util"
 	"github.com/stretchr/testify/assert"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 )
@@ -320,3 +324,117 @@ func Test_GetTransaction3(t *testing.T) {
 	exp.Status = &status
 	assert.Equal(t, &exp, txn)
 }
+
+// newTransactionsTestServer serves the transactions and transactionsByOwner connections with total transactions,
+// the cursor is the index of the transaction and only the odd transactions are related to testOwner
+func newTransactionsTestServer(t *testing.T, total int, queries *[]string) *Client {
+	srv := newTestServer(t, func(q string) any {
+		*queries = append(*queries, q)
+		field := "transactions"
+		var indexes []int
+		for i := 0; i < total; i++ {
+			if !strings.Contains(q, "transactionsByOwner(") || i%2 == 1 {
+				indexes = append(indexes, i)
+			}
+		}
+		if strings.Contains(q, "transactionsByOwner(") {
+			field = "transactionsByOwner"
+		}
+		page, pageInfo := testPage(q, indexes)
+		var edges []any
+		for _, i := range page {
+			edges = append(edges, map[string]any{"cursor": strconv.Itoa(i), "node": map[string]any{"id": testTxId(i).String()}})
+		}
+		return map[string]any{field: map[string]any{"pageInfo": pageInfo, "edges": edges}}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	return cli
+}
+
+func testTxId(i int) types.TransactionId {
+	return types.TransactionId{Hash: common.BigToHash(big.NewInt(int64(i)))}
+}
+
+func txIdsOf(txns []types.Transaction) []types.TransactionId {
+	ids := make([]types.TransactionId, len(txns))
+	for i, txn := range txns {
+		ids[i] = txn.Id
+	}
+	return ids
+}
+
+func Test_GetTransactionsByOwner(t *testing.T) {
+	var queries []string
+	cli := newTransactionsTestServer(t, 10, &queries)
+	ctx := context.Background()
+
+	txns, err := cli.GetTransactionsByOwner(ctx, testOwner, GetTransactionOption{}, PaginatorOption{PageSize: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []types.TransactionId{testTxId(1), testTxId(3), testTxId(5), testTxId(7), testTxId(9)}, txIdsOf(txns))
+	assert.Len(t, queries, 3)
+	assert.Contains(t, queries[0], `owner: "`+testOwner.String()+`"`)
+	assert.NotContains(t, queries[0], "receipts {")
+	assert.NotContains(t, queries[0], "status {")
+
+	queries = nil
+	txns, err = cli.GetTransactionsByOwner(ctx, testOwner, GetTransactionOption{WithStatus: true}, PaginatorOption{
+		PageSize: 2,
+		Backward: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []types.TransactionId{testTxId(9), testTxId(7), testTxId(5), testTxId(3), testTxId(1)}, txIdsOf(txns))
+	assert.Contains(t, queries[0], "status {")
+}
+
+func Test_TransactionsPaginator(t *testing.T) {
+	var queries []string
+	cli := newTransactionsTestServer(t, 5, &queries)
+	ctx := context.Background()
+
+	it := cli.TransactionsPaginator(GetTransactionOption{}, PaginatorOption{PageSize: 2}).Iterator()
+	var ids []types.TransactionId
+	for it.Next(ctx) {
+		ids = append(ids, it.Node().Id)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []types.TransactionId{testTxId(0), testTxId(1), testTxId(2), testTxId(3), testTxId(4)}, ids)
+	assert.Len(t, queries, 3)
+
+	p := cli.TransactionsPaginator(GetTransactionOption{}, PaginatorOption{
+		PageSize: 2,
+		Backward: true,
+		Cursor:   util.GetPointer[types.String]("3"),
+	})
+	txns, err := p.All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.TransactionId{testTxId(2), testTxId(1), testTxId(0)}, txIdsOf(txns))
+}
+
+func Test_GetTransactions(t *testing.T) {
+	var queries []string
+	srv := newTestServer(t, func(q string) any {
+		queries = append(queries, q)
+		data := make(map[string]any)
+		for _, m := range regexp.MustCompile(`(t\d+):transaction\(id: "(0x[0-9a-f]+)" \)`).FindAllStringSubmatch(q, -1) {
+			if m[2] == testTxId(0).String() {
+				data[m[1]] = nil
+			} else {
+				data[m[1]] = map[string]any{"id": m[2]}
+			}
+		}
+		return data
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+
+	txns, err := cli.GetTransactions(context.Background(), []types.QueryTransactionParams{
+		{Id: testTxId(2)},
+		{Id: testTxId(0)},
+		{Id: testTxId(1)},
+	}, GetTransactionOption{WithReceipts: true, WithStatus: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.Transaction{{Id: testTxId(2)}, nil, {Id: testTxId(1)}}, txns)
+	assert.Len(t, queries, 1)
+	assert.Contains(t, queries[0], "receipts {")
+}
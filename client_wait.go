@@ -0,0 +1,147 @@
+package fuel
+
+import (
+	"context"
+	"github.com/sentioxyz/fuel-go/types"
+	"time"
+)
+
+const DefaultWaitPollInterval = time.Second
+
+type WaitForTransactionOption struct {
+	WithReceipts bool
+	// Always poll by GetTransaction instead of using the statusChange subscription
+	DisableSubscription bool
+	// Interval of polling, DefaultWaitPollInterval will be used if it is not positive
+	PollInterval time.Duration
+	// Max time to wait, 0 means waiting until the context is done
+	Timeout time.Duration
+}
+
+type WaitOutcome int
+
+const (
+	// WaitTimeout means the transaction is still submitted or unknown when the timeout is reached
+	WaitTimeout WaitOutcome = iota
+	WaitSuccess
+	WaitFailure
+	WaitSqueezedOut
+)
+
+func (o WaitOutcome) String() string {
+	switch o {
+	case WaitTimeout:
+		return "Timeout"
+	case WaitSuccess:
+		return "Success"
+	case WaitFailure:
+		return "Failure"
+	case WaitSqueezedOut:
+		return "SqueezedOut"
+	default:
+		return "Unknown"
+	}
+}
+
+type WaitResult struct {
+	Outcome WaitOutcome
+	// The last known status, TypeName_ is empty if the transaction is not found until the timeout
+	Status types.TransactionStatus
+	// Reason of the failure or being squeezed out
+	Reason string
+}
+
+func newWaitResult(status types.TransactionStatus) WaitResult {
+	switch {
+	case status.SuccessStatus != nil:
+		return WaitResult{Outcome: WaitSuccess, Status: status}
+	case status.FailureStatus != nil:
+		return WaitResult{Outcome: WaitFailure, Status: status, Reason: string(status.FailureStatus.Reason)}
+	case status.SqueezedOutStatus != nil:
+		return WaitResult{Outcome: WaitSqueezedOut, Status: status, Reason: string(status.SqueezedOutStatus.Reason)}
+	default:
+		return WaitResult{Outcome: WaitTimeout, Status: status}
+	}
+}
+
+// WaitForTransaction blocks until the transaction reaches a final status or the timeout is reached.
+// It uses the statusChange subscription if the node serves it, otherwise polls the status by GetTransaction.
+// Reaching the timeout is not an error, the outcome of the result will be WaitTimeout,
+// but an error will be returned if the ctx is done.
+// The polling errors are logged and tolerated, the last one will be returned if the timeout is reached
+// without any successful poll.
+func (c *Client) WaitForTransaction(
+	ctx context.Context,
+	id types.TransactionId,
+	opt WaitForTransactionOption,
+) (WaitResult, error) {
+	waitCtx := ctx
+	if opt.Timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, opt.Timeout)
+		defer cancel()
+	}
+	var last types.TransactionStatus
+	if !opt.DisableSubscription {
+		sub, err := c.SubscribeStatusChange(waitCtx, id, SubscribeStatusOption{WithReceipts: opt.WithReceipts})
+		if err == nil {
+			for sub.Next() {
+				last = sub.Status()
+			}
+			if IsFinalStatus(last) {
+				return newWaitResult(last), nil
+			}
+			if ctx.Err() != nil {
+				return WaitResult{}, ctx.Err()
+			}
+			if waitCtx.Err() != nil {
+				return newWaitResult(last), nil
+			}
+			err = sub.Err()
+		}
+		// the subscription is not available or broken, fallback to polling
+		if c.logger != nil {
+			c.logger.Infof("subscribe status of transaction %s failed, fallback to polling: %v", id.String(), err)
+		}
+	}
+
+	interval := opt.PollInterval
+	if interval <= 0 {
+		interval = DefaultWaitPollInterval
+	}
+	// the node is probably unreliable if it is polled, so the errors are tolerated until the timeout
+	var lastErr error
+	responded := false
+	for {
+		txn, err := c.GetTransaction(waitCtx, types.QueryTransactionParams{Id: id}, GetTransactionOption{
+			WithStatus:   true,
+			WithReceipts: opt.WithReceipts,
+		})
+		if err == nil {
+			responded = true
+			if txn != nil && txn.Status != nil {
+				last = *txn.Status
+				if IsFinalStatus(last) {
+					return newWaitResult(last), nil
+				}
+			}
+		} else if waitCtx.Err() == nil {
+			lastErr = err
+			if c.logger != nil {
+				c.logger.Infof("poll status of transaction %s failed: %v", id.String(), err)
+			}
+		}
+		select {
+		case <-waitCtx.Done():
+		case <-time.After(interval):
+			continue
+		}
+		if ctx.Err() != nil {
+			return WaitResult{}, ctx.Err()
+		}
+		if !responded && lastErr != nil {
+			return WaitResult{}, lastErr
+		}
+		return newWaitResult(last), nil
+	}
+}
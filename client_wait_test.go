@@ -0,0 +1,101 @@
+package fuel
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_WaitForTransaction(t *testing.T) {
+	txID := types.TransactionId{Hash: common.HexToHash("0x01")}
+	ctx := context.Background()
+	{
+		cli := newSSETestServer(t, func(int) ([]any, bool) {
+			return []any{
+				statusEvent("statusChange", "SubmittedStatus"),
+				statusEvent("statusChange", "SqueezedOutStatus"),
+			}, false
+		})
+		result, err := cli.WaitForTransaction(ctx, txID, WaitForTransactionOption{})
+		assert.NoError(t, err)
+		assert.Equal(t, WaitSqueezedOut, result.Outcome)
+		assert.Equal(t, "gas price too low", result.Reason)
+	}
+	{
+		// the subscription endpoint is not available, fallback to polling and tolerate the polling errors
+		var polled atomic.Int32
+		srv := newTestServer(t, func(string) any {
+			switch polled.Add(1) {
+			case 1:
+				return QueryErrors{{Message: "service unavailable"}}
+			case 2:
+				return map[string]any{"transaction": nil}
+			case 3:
+				return map[string]any{"transaction": map[string]any{
+					"id":      txID.String(),
+					"outputs": []any{},
+					"status":  statusEvent("status", "SubmittedStatus").(map[string]any)["status"],
+				}}
+			default:
+				return map[string]any{"transaction": map[string]any{
+					"id":      txID.String(),
+					"outputs": []any{},
+					"status": map[string]any{
+						"__typename":    "FailureStatus",
+						"transactionId": txID.String(),
+						"blockHeight":   "5",
+						"block":         map[string]any{"id": common.HexToHash("0x02").String()},
+						"transaction":   map[string]any{"id": txID.String(), "outputs": []any{}},
+						"time":          "4611686020140536983",
+						"reason":        "Revert(123)",
+						"receipts":      []any{},
+						"totalGas":      "10",
+						"totalFee":      "1",
+					},
+				}}
+			}
+		})
+		cli := NewClient(srv.URL)
+		cli.SetDebug(true)
+		result, err := cli.WaitForTransaction(ctx, txID, WaitForTransactionOption{
+			WithReceipts: true,
+			PollInterval: time.Millisecond,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, WaitFailure, result.Outcome)
+		assert.Equal(t, "Revert(123)", result.Reason)
+		assert.Equal(t, int32(4), polled.Load())
+	}
+	{
+		// the last polling error is returned if all the polls failed until the timeout
+		srv := newTestServer(t, func(string) any {
+			return QueryErrors{{Message: "service unavailable"}}
+		})
+		cli := NewClient(srv.URL)
+		_, err := cli.WaitForTransaction(ctx, txID, WaitForTransactionOption{
+			DisableSubscription: true,
+			PollInterval:        time.Millisecond,
+			Timeout:             time.Millisecond * 50,
+		})
+		assert.ErrorContains(t, err, "service unavailable")
+	}
+	{
+		// timeout
+		cli := newSSETestServer(t, func(int) ([]any, bool) {
+			return []any{statusEvent("statusChange", "SubmittedStatus")}, true
+		})
+		result, err := cli.WaitForTransaction(ctx, txID, WaitForTransactionOption{Timeout: time.Millisecond * 50})
+		assert.NoError(t, err)
+		assert.Equal(t, WaitTimeout, result.Outcome)
+		assert.NotNil(t, result.Status.SubmittedStatus)
+
+		cctx, cancel := context.WithTimeout(ctx, time.Millisecond*50)
+		defer cancel()
+		_, err = cli.WaitForTransaction(cctx, txID, WaitForTransactionOption{Timeout: time.Second})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	}
+}
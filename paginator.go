@@ -0,0 +1,202 @@
+package fuel
+
+import (
+	"context"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/query"
+	"github.com/sentioxyz/fuel-go/types"
+	"reflect"
+)
+
+const DefaultPageSize = 100
+
+// Edge is the common shape of the *Edge types in the schema
+type Edge[NODE any] struct {
+	Node   NODE         `json:"node" kind:"OBJECT"`
+	Cursor types.String `json:"cursor" kind:"SCALAR"`
+}
+
+// Connection is the common shape of the *Connection types in the schema, only the edges are selected
+type Connection[NODE any] struct {
+	PageInfo types.PageInfo `json:"pageInfo" kind:"OBJECT"`
+	Edges    []Edge[NODE]   `json:"edges" kind:"OBJECT"`
+}
+
+type PaginatorOption struct {
+	// Number of items per page, DefaultPageSize will be used if it is not positive
+	PageSize int32
+	// Walk with last/before instead of first/after, the node returns the items from the newest to the oldest
+	Backward bool
+	// Resume from the cursor, which is the cursor of a page or an item
+	Cursor *types.String
+}
+
+// Paginator walks through a connection field page by page.
+// The params should be a Query*Params with First, After, Last and Before fields, such as types.QueryBlocksParams,
+// the pagination fields in it will be overwritten.
+type Paginator[NODE any] struct {
+	cli           *Client
+	fieldName     string
+	params        any
+	ignoreChecker query.IgnoreChecker
+	opt           PaginatorOption
+
+	cursor *types.String
+	done   bool
+}
+
+func NewPaginator[NODE any](
+	cli *Client,
+	fieldName string,
+	params any,
+	ignoreChecker query.IgnoreChecker,
+	opt PaginatorOption,
+) *Paginator[NODE] {
+	if opt.PageSize <= 0 {
+		opt.PageSize = DefaultPageSize
+	}
+	return &Paginator[NODE]{
+		cli:           cli,
+		fieldName:     fieldName,
+		params:        params,
+		ignoreChecker: ignoreChecker,
+		opt:           opt,
+		cursor:        opt.Cursor,
+	}
+}
+
+// HasNext returns false after the last page is fetched
+func (p *Paginator[NODE]) HasNext() bool {
+	return !p.done
+}
+
+// Cursor returns the cursor to resume the pagination after the fetched pages
+func (p *Paginator[NODE]) Cursor() *types.String {
+	return p.cursor
+}
+
+func (p *Paginator[NODE]) buildParams() (any, error) {
+	pt := reflect.TypeOf(p.params)
+	if pt == nil || pt.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("params of %s should be a struct, but got %T", p.fieldName, p.params)
+	}
+	params := reflect.New(pt).Elem()
+	params.Set(reflect.ValueOf(p.params))
+	set := func(fieldName string, value any) error {
+		field := params.FieldByName(fieldName)
+		if !field.IsValid() {
+			return fmt.Errorf("params %s do not have pagination field %s", pt.Name(), fieldName)
+		}
+		if value == nil {
+			field.Set(reflect.Zero(field.Type()))
+		} else {
+			field.Set(reflect.ValueOf(value))
+		}
+		return nil
+	}
+	size := types.Int(p.opt.PageSize)
+	sizeField, cursorField, otherFields := "First", "After", []string{"Last", "Before"}
+	if p.opt.Backward {
+		sizeField, cursorField, otherFields = "Last", "Before", []string{"First", "After"}
+	}
+	if err := set(sizeField, &size); err != nil {
+		return nil, err
+	}
+	if err := set(cursorField, p.cursor); err != nil {
+		return nil, err
+	}
+	for _, fieldName := range otherFields {
+		if err := set(fieldName, nil); err != nil {
+			return nil, err
+		}
+	}
+	return params.Interface(), nil
+}
+
+// Next fetches the next page, the edges are in the walking order, which is from the newest to the oldest
+// if PaginatorOption.Backward is true.
+// Note that fuel-core walks the items in the reverse order for last/before and the pageInfo is in the walking
+// direction, so endCursor is the cursor of the oldest item and hasNextPage tells if there are older items.
+func (p *Paginator[NODE]) Next(ctx context.Context) (Connection[NODE], error) {
+	if p.done {
+		return Connection[NODE]{}, fmt.Errorf("no more pages of %s", p.fieldName)
+	}
+	params, err := p.buildParams()
+	if err != nil {
+		return Connection[NODE]{}, err
+	}
+	page, err := ExecuteField(ctx, p.cli, query.Root[Connection[NODE]](p.fieldName, params).Ignore(p.ignoreChecker))
+	if err != nil {
+		return Connection[NODE]{}, err
+	}
+	next, hasNext := page.PageInfo.EndCursor, page.PageInfo.HasNextPage
+	if next != nil {
+		p.cursor = next
+	}
+	p.done = !bool(hasNext) || next == nil
+	return page, nil
+}
+
+// All fetches all the remaining pages and returns the nodes in the walking order
+func (p *Paginator[NODE]) All(ctx context.Context) ([]NODE, error) {
+	var nodes []NODE
+	for p.HasNext() {
+		page, err := p.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, edge := range page.Edges {
+			nodes = append(nodes, edge.Node)
+		}
+	}
+	return nodes, nil
+}
+
+// Iterator returns an item level iterator, which fetches the pages on demand
+func (p *Paginator[NODE]) Iterator() *Iterator[NODE] {
+	return &Iterator[NODE]{paginator: p}
+}
+
+// Iterator walks through the items of a connection, the usage is like:
+//
+//	it := paginator.Iterator()
+//	for it.Next(ctx) {
+//	  node := it.Node()
+//	}
+//	if err := it.Err(); err != nil {
+//	  ...
+//	}
+type Iterator[NODE any] struct {
+	paginator *Paginator[NODE]
+	edges     []Edge[NODE]
+	current   Edge[NODE]
+	err       error
+}
+
+// Next moves to the next item, returns false if there are no more items or an error occurred
+func (it *Iterator[NODE]) Next(ctx context.Context) bool {
+	for len(it.edges) == 0 {
+		if it.err != nil || !it.paginator.HasNext() {
+			return false
+		}
+		var page Connection[NODE]
+		page, it.err = it.paginator.Next(ctx)
+		it.edges = page.Edges
+	}
+	it.current, it.edges = it.edges[0], it.edges[1:]
+	return true
+}
+
+func (it *Iterator[NODE]) Node() NODE {
+	return it.current.Node
+}
+
+// Cursor returns the cursor of the current item, which can be used as PaginatorOption.Cursor
+// to resume the iteration after the current item
+func (it *Iterator[NODE]) Cursor() types.String {
+	return it.current.Cursor
+}
+
+func (it *Iterator[NODE]) Err() error {
+	return it.err
+}
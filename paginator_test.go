@@ -0,0 +1,140 @@
+package fuel
+
+import (
+	"context"
+	"github.com/sentioxyz/fuel-go/query"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/sentioxyz/fuel-go/util"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+var testPageArgPattern = regexp.MustCompile(`(first|after|last|before): "?(\d+)"?`)
+
+// testPage pages the ascending keys like fuel-core, the keys are also the cursors.
+// With last/before the keys are walked in the reverse order, hasNextPage tells if there are more keys
+// in the walking direction and hasPreviousPage tells if the page starts from a cursor.
+func testPage(q string, keys []int) ([]int, map[string]any) {
+	args := make(map[string]int)
+	for _, arg := range testPageArgPattern.FindAllStringSubmatch(q, -1) {
+		args[arg[1]], _ = strconv.Atoi(arg[2])
+	}
+	count, forward := args["first"]
+	start, hasStart := args["after"]
+	if !forward {
+		count = args["last"]
+		start, hasStart = args["before"]
+		keys = slices.Clone(keys)
+		slices.Reverse(keys)
+	}
+	var page []int
+	hasNext := false
+	for _, key := range keys {
+		if hasStart && ((forward && key <= start) || (!forward && key >= start)) {
+			continue
+		}
+		if len(page) == count {
+			hasNext = true
+			break
+		}
+		page = append(page, key)
+	}
+	pageInfo := map[string]any{"hasPreviousPage": hasStart, "hasNextPage": hasNext}
+	if len(page) > 0 {
+		pageInfo["startCursor"] = strconv.Itoa(page[0])
+		pageInfo["endCursor"] = strconv.Itoa(page[len(page)-1])
+	}
+	return page, pageInfo
+}
+
+// newBlocksTestServer serves the blocks connection with blocks of height [0,total), the cursor is the height
+func newBlocksTestServer(t *testing.T, total int) *Client {
+	heights := make([]int, total)
+	for h := range heights {
+		heights[h] = h
+	}
+	srv := newTestServer(t, func(q string) any {
+		page, pageInfo := testPage(q, heights)
+		var edges []any
+		for _, h := range page {
+			edges = append(edges, map[string]any{"cursor": strconv.Itoa(h), "node": map[string]any{"height": strconv.Itoa(h)}})
+		}
+		return map[string]any{"blocks": map[string]any{"pageInfo": pageInfo, "edges": edges}}
+	})
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+	return cli
+}
+
+func heightsOf(blocks []types.Block) []types.U32 {
+	heights := make([]types.U32, len(blocks))
+	for i, block := range blocks {
+		heights[i] = block.Height
+	}
+	return heights
+}
+
+func Test_Paginator(t *testing.T) {
+	cli := newBlocksTestServer(t, 5)
+	ctx := context.Background()
+	ignore := query.IgnoreOtherFields(types.Block{}, "Height")
+
+	p := NewPaginator[types.Block](cli, "blocks", types.QueryBlocksParams{}, ignore, PaginatorOption{PageSize: 2})
+	var pages [][]types.U32
+	for p.HasNext() {
+		page, err := p.Next(ctx)
+		assert.NoError(t, err)
+		var heights []types.U32
+		for _, edge := range page.Edges {
+			heights = append(heights, edge.Node.Height)
+		}
+		pages = append(pages, heights)
+	}
+	assert.Equal(t, [][]types.U32{{0, 1}, {2, 3}, {4}}, pages)
+	assert.Equal(t, util.GetPointer[types.String]("4"), p.Cursor())
+
+	blocks, err := NewPaginator[types.Block](cli, "blocks", types.QueryBlocksParams{}, ignore, PaginatorOption{
+		PageSize: 2,
+		Backward: true,
+	}).All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.U32{4, 3, 2, 1, 0}, heightsOf(blocks))
+
+	blocks, err = NewPaginator[types.Block](cli, "blocks", types.QueryBlocksParams{}, ignore, PaginatorOption{
+		PageSize: 3,
+		Cursor:   util.GetPointer[types.String]("1"),
+	}).All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.U32{2, 3, 4}, heightsOf(blocks))
+
+	it := NewPaginator[types.Block](cli, "blocks", types.QueryBlocksParams{}, ignore, PaginatorOption{PageSize: 2}).Iterator()
+	var heights []types.U32
+	var cursors []types.String
+	for it.Next(ctx) {
+		heights = append(heights, it.Node().Height)
+		cursors = append(cursors, it.Cursor())
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []types.U32{0, 1, 2, 3, 4}, heights)
+	assert.Equal(t, []types.String{"0", "1", "2", "3", "4"}, cursors)
+
+	it = NewPaginator[types.Block](cli, "blocks", types.QueryBlocksParams{}, ignore, PaginatorOption{
+		PageSize: 2,
+		Backward: true,
+		Cursor:   util.GetPointer[types.String]("4"),
+	}).Iterator()
+	heights, cursors = nil, nil
+	for it.Next(ctx) {
+		heights = append(heights, it.Node().Height)
+		cursors = append(cursors, it.Cursor())
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []types.U32{3, 2, 1, 0}, heights)
+	assert.Equal(t, []types.String{"3", "2", "1", "0"}, cursors)
+
+	_, err = NewPaginator[types.Block](cli, "chain", types.QueryChainParams{}, ignore, PaginatorOption{}).Next(ctx)
+	assert.EqualError(t, err, "params QueryChainParams do not have pagination field First")
+}
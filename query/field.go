@@ -0,0 +1,129 @@
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/sentioxyz/fuel-go/util"
+	"reflect"
+)
+
+// Selection is a root field of an operation
+type Selection interface {
+	Gen(b Builder) string
+}
+
+// Field is a root field whose selection is generated from T, and the result of which is decoded into T.
+// Use a pointer type as T for nullable fields, such as Root[*types.Block]("block", param).
+type Field[T any] struct {
+	name   string
+	alias  string
+	param  any
+	ignore IgnoreChecker
+}
+
+func Root[T any](name string, param any) Field[T] {
+	return Field[T]{name: name, param: param}
+}
+
+// As sets the alias of the field, which is necessary when querying a field multiple times in one operation
+func (f Field[T]) As(alias string) Field[T] {
+	f.alias = alias
+	return f
+}
+
+// Select keeps only the named fields of T, names are the go field names
+func (f Field[T]) Select(fieldNames ...string) Field[T] {
+	return f.Ignore(IgnoreOtherFields(reflect.Zero(util.UnwrapGoType(reflect.TypeOf((*T)(nil)).Elem())).Interface(), fieldNames...))
+}
+
+// Ignore appends ignore checkers to the selection
+func (f Field[T]) Ignore(checkers ...IgnoreChecker) Field[T] {
+	f.ignore = MergeIgnores(append([]IgnoreChecker{f.ignore}, checkers...)...)
+	return f
+}
+
+// Key is the name of the field in the result data
+func (f Field[T]) Key() string {
+	if f.alias != "" {
+		return f.alias
+	}
+	return f.name
+}
+
+func (f Field[T]) Gen(b Builder) string {
+	var buf bytes.Buffer
+	var w = util.Output{Writer: &buf}
+	w.Out("%s", b.Prefix)
+	if f.alias != "" {
+		w.Out("%s:", f.alias)
+	}
+	w.Out("%s", f.name)
+	if f.param != nil {
+		if param := Simple.GenParam(f.param); param != "" {
+			w.Out("(%s)", param)
+		}
+	}
+	objType := util.UnwrapGoType(reflect.TypeOf((*T)(nil)).Elem())
+	if isObject(objType) {
+		w.Out(" {%s", b.EOL)
+		w.Out("%s", b.indent().genObjectQueryCached(objType, f.ignore))
+		w.Out("%s}", b.Prefix)
+	}
+	w.Out("%s", b.EOL)
+	return buf.String()
+}
+
+// Decode extracts the result of the field from the data of the response
+func (f Field[T]) Decode(data map[string]json.RawMessage) (result T, err error) {
+	raw, has := data[f.Key()]
+	if !has {
+		return result, fmt.Errorf("miss field %q in the result", f.Key())
+	}
+	if err = json.Unmarshal(raw, &result); err != nil {
+		return result, fmt.Errorf("decode field %q failed: %w", f.Key(), err)
+	}
+	return result, nil
+}
+
+// isObject returns false for scalars and enums, which have no sub-selection
+func isObject(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		if _, has := typ.Field(i).Tag.Lookup("json"); has {
+			return true
+		}
+	}
+	return false
+}
+
+func (b Builder) genOperation(operation string, selections []Selection) string {
+	var buf bytes.Buffer
+	var w = util.Output{Writer: &buf}
+	if operation != "" {
+		w.Out("%s ", operation)
+	}
+	w.Out("{%s", b.EOL)
+	for _, sel := range selections {
+		w.Out("%s", sel.Gen(b.indent()))
+	}
+	w.Out("}")
+	return buf.String()
+}
+
+// GenQuery generates a query operation with all the selections
+func (b Builder) GenQuery(selections ...Selection) string {
+	return b.genOperation("", selections)
+}
+
+// GenMutation generates a mutation operation with all the selections, which will be executed serially by the node
+func (b Builder) GenMutation(selections ...Selection) string {
+	return b.genOperation("mutation", selections)
+}
+
+// GenSubscription generates a subscription operation, the node only accepts one selection in a subscription
+func (b Builder) GenSubscription(selection Selection) string {
+	return b.genOperation("subscription", []Selection{selection})
+}
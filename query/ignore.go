@@ -1,64 +1,193 @@
 package query
 
 import (
+	"fmt"
+	"maps"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 )
 
-type IgnoreChecker func(object reflect.Type, field reflect.StructField) bool
+type ignoreKind uint8
 
-func IgnoreObjects(objs ...any) IgnoreChecker {
-	objTypeSet := make(map[reflect.Type]bool)
-	for _, obj := range objs {
-		objTypeSet[reflect.TypeOf(obj)] = true
+const (
+	ignoreObjects ignoreKind = iota
+	ignoreField
+	ignoreOtherFields
+)
+
+type ignoreRule struct {
+	kind   ignoreKind
+	object reflect.Type
+	// field names joined by comma, sorted for ignoreOtherFields
+	fields string
+}
+
+func (r ignoreRule) String() string {
+	return fmt.Sprintf("%d|%s.%s|%s", r.kind, r.object.PkgPath(), r.object.String(), r.fields)
+}
+
+type ignoreSet struct {
+	objects     map[reflect.Type]bool
+	fields      map[reflect.Type]map[string]bool
+	otherFields map[reflect.Type][]map[string]bool
+}
+
+// IgnoreChecker describes the fields which will be ignored in the selection.
+// Checkers built from the same rules are equal, so it can be used as a part of a cache key.
+// The zero value ignores nothing.
+type IgnoreChecker struct {
+	set *ignoreSet
+}
+
+// internedIgnoreSets maps the canonical description of the rules to the *ignoreSet built from them.
+// Different types may have the same name, such as the types declared in functions,
+// so the rules of the sets with the same description need to be compared.
+var (
+	internedIgnoreSetsLock sync.Mutex
+	internedIgnoreSets     = make(map[string][]*ignoreSet)
+)
+
+func newIgnoreChecker(rules map[ignoreRule]bool) IgnoreChecker {
+	if len(rules) == 0 {
+		return IgnoreChecker{}
+	}
+	desc := make([]string, 0, len(rules))
+	for rule := range rules {
+		desc = append(desc, rule.String())
+	}
+	sort.Strings(desc)
+	key := strings.Join(desc, ";")
+	internedIgnoreSetsLock.Lock()
+	defer internedIgnoreSetsLock.Unlock()
+	for _, set := range internedIgnoreSets[key] {
+		if maps.Equal(IgnoreChecker{set: set}.rules(), rules) {
+			return IgnoreChecker{set: set}
+		}
+	}
+	set := &ignoreSet{
+		objects:     make(map[reflect.Type]bool),
+		fields:      make(map[reflect.Type]map[string]bool),
+		otherFields: make(map[reflect.Type][]map[string]bool),
 	}
-	return func(_ reflect.Type, field reflect.StructField) bool {
-		fieldType := field.Type
-		for {
-			if objTypeSet[fieldType] {
-				return true
+	for rule := range rules {
+		switch rule.kind {
+		case ignoreObjects:
+			set.objects[rule.object] = true
+		case ignoreField:
+			if set.fields[rule.object] == nil {
+				set.fields[rule.object] = make(map[string]bool)
 			}
-			switch fieldType.Kind() {
-			case reflect.Pointer, reflect.Slice, reflect.Array:
-				fieldType = fieldType.Elem()
-			default:
-				return false
+			set.fields[rule.object][rule.fields] = true
+		case ignoreOtherFields:
+			fieldNameSet := make(map[string]bool)
+			if rule.fields != "" {
+				for _, fieldName := range strings.Split(rule.fields, ",") {
+					fieldNameSet[fieldName] = true
+				}
 			}
+			set.otherFields[rule.object] = append(set.otherFields[rule.object], fieldNameSet)
 		}
 	}
+	internedIgnoreSets[key] = append(internedIgnoreSets[key], set)
+	return IgnoreChecker{set: set}
 }
 
-func IgnoreField(obj any, fieldName string) IgnoreChecker {
-	objType := reflect.TypeOf(obj)
-	return func(object reflect.Type, field reflect.StructField) bool {
-		return object == objType && field.Name == fieldName
+func (c IgnoreChecker) rules() map[ignoreRule]bool {
+	rules := make(map[ignoreRule]bool)
+	if c.set == nil {
+		return rules
+	}
+	for object := range c.set.objects {
+		rules[ignoreRule{kind: ignoreObjects, object: object}] = true
+	}
+	for object, fieldNameSet := range c.set.fields {
+		for fieldName := range fieldNameSet {
+			rules[ignoreRule{kind: ignoreField, object: object, fields: fieldName}] = true
+		}
+	}
+	for object, fieldNameSets := range c.set.otherFields {
+		for _, fieldNameSet := range fieldNameSets {
+			rules[ignoreRule{kind: ignoreOtherFields, object: object, fields: joinSorted(fieldNameSet)}] = true
+		}
 	}
+	return rules
+}
+
+func joinSorted(set map[string]bool) string {
+	items := make([]string, 0, len(set))
+	for item := range set {
+		items = append(items, item)
+	}
+	sort.Strings(items)
+	return strings.Join(items, ",")
+}
+
+// Ignore returns whether the field of the object should be ignored
+func (c IgnoreChecker) Ignore(object reflect.Type, field reflect.StructField) bool {
+	if c.set == nil {
+		return false
+	}
+	if c.set.fields[object][field.Name] {
+		return true
+	}
+	for _, fieldNameSet := range c.set.otherFields[object] {
+		if !fieldNameSet[field.Name] {
+			return true
+		}
+	}
+	fieldType := field.Type
+	for {
+		if c.set.objects[fieldType] {
+			return true
+		}
+		switch fieldType.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Array:
+			fieldType = fieldType.Elem()
+		default:
+			return false
+		}
+	}
+}
+
+func IgnoreObjects(objs ...any) IgnoreChecker {
+	rules := make(map[ignoreRule]bool)
+	for _, obj := range objs {
+		rules[ignoreRule{kind: ignoreObjects, object: reflect.TypeOf(obj)}] = true
+	}
+	return newIgnoreChecker(rules)
+}
+
+func IgnoreField(obj any, fieldName string) IgnoreChecker {
+	return newIgnoreChecker(map[ignoreRule]bool{
+		{kind: ignoreField, object: reflect.TypeOf(obj), fields: fieldName}: true,
+	})
 }
 
 func IgnoreOtherFields(obj any, fieldNames ...string) IgnoreChecker {
-	objType := reflect.TypeOf(obj)
 	fieldNameSet := make(map[string]bool)
 	for _, fieldName := range fieldNames {
 		fieldNameSet[fieldName] = true
 	}
-	return func(object reflect.Type, field reflect.StructField) bool {
-		return object == objType && !fieldNameSet[field.Name]
-	}
+	return newIgnoreChecker(map[ignoreRule]bool{
+		{kind: ignoreOtherFields, object: reflect.TypeOf(obj), fields: joinSorted(fieldNameSet)}: true,
+	})
 }
 
 func MergeIgnores(checkers ...IgnoreChecker) IgnoreChecker {
 	switch len(checkers) {
 	case 0:
-		return nil
+		return IgnoreChecker{}
 	case 1:
 		return checkers[0]
 	default:
-		return func(object reflect.Type, field reflect.StructField) bool {
-			for _, checker := range checkers {
-				if checker(object, field) {
-					return true
-				}
+		rules := make(map[ignoreRule]bool)
+		for _, checker := range checkers {
+			for rule := range checker.rules() {
+				rules[rule] = true
 			}
-			return false
 		}
+		return newIgnoreChecker(rules)
 	}
 }
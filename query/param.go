@@ -5,8 +5,41 @@ import (
 	"fmt"
 	"github.com/sentioxyz/fuel-go/util"
 	"reflect"
+	"strconv"
+	"strings"
 )
 
+func (b Builder) genValue(value reflect.Value, kind string) string {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return "null"
+		}
+		return b.genValue(value.Elem(), kind)
+	case reflect.Slice:
+		items := make([]string, value.Len())
+		for i := range items {
+			items[i] = b.genValue(value.Index(i), kind)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Float32, reflect.Float64:
+		// Boolean, Int and Float are the built-in scalars, which can not be quoted
+		return fmt.Sprint(value.Interface())
+	}
+	switch kind {
+	case "INPUT_OBJECT":
+		return "{ " + Simple.genParam(value) + "}"
+	case "ENUM":
+		return value.String()
+	}
+	if str, is := value.Interface().(fmt.Stringer); is {
+		return strconv.Quote(str.String())
+	}
+	return strconv.Quote(value.String())
+}
+
 func (b Builder) genParam(value reflect.Value) string {
 	var buf bytes.Buffer
 	var w = util.Output{Writer: &buf}
@@ -17,17 +50,18 @@ func (b Builder) genParam(value reflect.Value) string {
 			continue
 		}
 		field := value.Field(i)
-		if field.Kind() == reflect.Pointer && field.IsNil() {
-			continue
+		if field.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				continue
+			}
+			field = field.Elem()
 		}
-		if tag.Get("kind") == "INPUT_OBJECT" {
+		if tag.Get("kind") == "INPUT_OBJECT" && field.Kind() == reflect.Struct {
 			w.Out("%s%s: {%s", b.Prefix, name, b.EOL)
 			w.Out(b.indent().genParam(field))
 			w.Out("%s}%s", b.Prefix, b.EOL)
-		} else if str, is := field.Interface().(fmt.Stringer); is {
-			w.Out("%s%s: %q%s", b.Prefix, name, str.String(), b.EOL)
 		} else {
-			w.Out("%s%s: %s%s", b.Prefix, name, field.String(), b.EOL)
+			w.Out("%s%s: %s%s", b.Prefix, name, b.genValue(field, tag.Get("kind")), b.EOL)
 		}
 	}
 	return buf.String()
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"github.com/sentioxyz/fuel-go/util"
 	"reflect"
+	"sync"
 )
 
 func (b Builder) indent() Builder {
@@ -26,7 +27,7 @@ func (b Builder) genObjectQuery(objType reflect.Type, ignoreChecker IgnoreChecke
 			if field.Name == util.UnionTypeFieldName {
 				continue
 			}
-			if ignoreChecker != nil && ignoreChecker(objType, field) {
+			if ignoreChecker.Ignore(objType, field) {
 				continue
 			}
 			w.Out("%s... on %s {%s", b.Prefix, field.Name, b.EOL)
@@ -42,7 +43,7 @@ func (b Builder) genObjectQuery(objType reflect.Type, ignoreChecker IgnoreChecke
 		if !has {
 			continue
 		}
-		if ignoreChecker != nil && ignoreChecker(objType, field) {
+		if ignoreChecker.Ignore(objType, field) {
 			continue
 		}
 		switch field.Tag.Get("kind") {
@@ -57,6 +58,27 @@ func (b Builder) genObjectQuery(objType reflect.Type, ignoreChecker IgnoreChecke
 	return buf.String()
 }
 
+type selectionKey struct {
+	builder       Builder
+	objType       reflect.Type
+	ignoreChecker IgnoreChecker
+}
+
+// selectionCache caches the generated selections, the key is selectionKey
+var selectionCache sync.Map
+
+func (b Builder) genObjectQueryCached(objType reflect.Type, ignoreChecker IgnoreChecker) string {
+	key := selectionKey{builder: b, objType: objType, ignoreChecker: ignoreChecker}
+	if selection, has := selectionCache.Load(key); has {
+		return selection.(string)
+	}
+	selection := b.genObjectQuery(objType, ignoreChecker)
+	selectionCache.Store(key, selection)
+	return selection
+}
+
+// GenObjectQuery generates the selection of the object, the result is cached by
+// the builder, the object type and the ignore checker, so it only walks the types once
 func (b Builder) GenObjectQuery(obj any, ignoreChecker IgnoreChecker) string {
-	return b.genObjectQuery(util.UnwrapGoType(reflect.TypeOf(obj)), ignoreChecker)
+	return b.genObjectQueryCached(util.UnwrapGoType(reflect.TypeOf(obj)), ignoreChecker)
 }
@@ -0,0 +1,39 @@
+package fuel
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestServer starts a local endpoint which validates every received query against the schema
+// and answers it with the data returned by handle, or with the errors if handle returns QueryErrors
+func newTestServer(t *testing.T, handle func(query string) any) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query string `json:"query"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp := make(map[string]any)
+		if err := ValidateQuery(req.Query); err != nil {
+			resp["errors"] = err
+		} else if data := handle(req.Query); isQueryErrors(data) {
+			resp["errors"] = data
+		} else {
+			resp["data"] = data
+		}
+		w.Header().Set("content-type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func isQueryErrors(data any) bool {
+	_, is := data.(QueryErrors)
+	return is
+}
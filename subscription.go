@@ -0,0 +1,228 @@
+package fuel
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type SubscriptionOption struct {
+	// Max times to reconnect when the stream is broken or closed before the final data, failed reconnections
+	// are also counted, 0 means never reconnect
+	MaxReconnects int
+	// Wait before each reconnection
+	ReconnectInterval time.Duration
+}
+
+// Subscription reads the data pushed by the node through the server-sent events stream, the usage is like:
+//
+//	for sub.Next() {
+//	  data := sub.Data()
+//	}
+//	if err := sub.Err(); err != nil {
+//	  ...
+//	}
+//
+// The stream will be closed if the context of it is canceled or Close is called.
+type Subscription[DATA any] struct {
+	ctx   context.Context
+	cli   *Client
+	query string
+	opt   SubscriptionOption
+	// the stream is expected to end after the final data
+	isFinal func(DATA) bool
+
+	body       io.ReadCloser
+	reader     *bufio.Reader
+	reconnects int
+	current    DATA
+	done       bool
+	err        error
+}
+
+// ExecuteSubscription starts a subscription, isFinal tells whether the stream is expected to end after the data,
+// if the stream ends before that, it is broken and will be reconnected if possible
+func ExecuteSubscription[DATA any](
+	ctx context.Context,
+	cli *Client,
+	query string,
+	opt SubscriptionOption,
+	isFinal func(DATA) bool,
+) (*Subscription[DATA], error) {
+	if cli.debug {
+		if err := ValidateQuery(query); err != nil {
+			return nil, err
+		}
+	}
+	s := &Subscription[DATA]{
+		ctx:     ctx,
+		cli:     cli,
+		query:   query,
+		opt:     opt,
+		isFinal: isFinal,
+	}
+	if err := s.connect(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Subscription[DATA]) connect() error {
+	if s.cli.logger != nil {
+		s.cli.logger.Infof("execute subscription: %s", s.query)
+	}
+	var reqBody bytes.Buffer
+	if err := json.NewEncoder(&reqBody).Encode(map[string]any{"query": s.query}); err != nil {
+		return fmt.Errorf("build request failed: %w", err)
+	}
+	req, err := http.NewRequestWithContext(s.ctx, "POST", s.cli.subscriptionURL(), &reqBody)
+	if err != nil {
+		return fmt.Errorf("build request failed: %w", err)
+	}
+	req.Header.Add("content-type", "application/json")
+	req.Header.Add("Accept", "text/event-stream")
+
+	resp, err := s.cli.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request failed: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("content-type"), "text/event-stream") {
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		var result struct {
+			Errors QueryErrors `json:"errors"`
+		}
+		if json.Unmarshal(respBody, &result) == nil && len(result.Errors) > 0 {
+			return result.Errors
+		}
+		return fmt.Errorf("subscribe failed with status %q: %s", resp.Status, string(respBody))
+	}
+	s.body, s.reader = resp.Body, bufio.NewReader(resp.Body)
+	return nil
+}
+
+func (s *Subscription[DATA]) closeBody() {
+	if s.body != nil {
+		_ = s.body.Close()
+		s.body, s.reader = nil, nil
+	}
+}
+
+// readEvent returns the data of the next event, comments and other fields are skipped
+func (s *Subscription[DATA]) readEvent() ([]byte, error) {
+	var data [][]byte
+	for {
+		line, err := s.reader.ReadBytes('\n')
+		if err != nil {
+			if err == io.EOF && len(line) > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		line = bytes.TrimRight(line, "\r\n")
+		if len(line) == 0 {
+			if len(data) > 0 {
+				return bytes.Join(data, []byte{'\n'}), nil
+			}
+			continue
+		}
+		if value, is := bytes.CutPrefix(line, []byte("data:")); is {
+			data = append(data, bytes.TrimPrefix(value, []byte{' '}))
+		}
+	}
+}
+
+func (s *Subscription[DATA]) fail(err error) bool {
+	s.closeBody()
+	s.done, s.err = true, err
+	return false
+}
+
+// Next waits for the next data, returns false if the stream is terminated or an error occurred
+func (s *Subscription[DATA]) Next() bool {
+	for !s.done {
+		var err error
+		if s.body == nil {
+			if err = s.connect(); err != nil {
+				var queryErrs QueryErrors
+				if errors.As(err, &queryErrs) {
+					// the query is rejected by the node, reconnecting will not help
+					return s.fail(err)
+				}
+				err = fmt.Errorf("reconnect failed: %w", err)
+			}
+		}
+		if err == nil {
+			var payload []byte
+			if payload, err = s.readEvent(); err == nil {
+				if s.cli.logger != nil {
+					s.cli.logger.Infof("subscription event(len: %d): %s", len(payload), string(payload))
+				}
+				var result struct {
+					Data   DATA        `json:"data"`
+					Errors QueryErrors `json:"errors"`
+				}
+				if err = json.Unmarshal(payload, &result); err != nil {
+					return s.fail(fmt.Errorf("parse event failed: %w", err))
+				}
+				if len(result.Errors) > 0 {
+					return s.fail(result.Errors)
+				}
+				s.current = result.Data
+				if s.isFinal != nil && s.isFinal(result.Data) {
+					s.closeBody()
+					s.done = true
+				}
+				return true
+			}
+			s.closeBody()
+			if s.isFinal == nil && err == io.EOF && s.ctx.Err() == nil {
+				// no final data is expected, the stream is closed cleanly
+				s.done = true
+				return false
+			}
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+		}
+		if s.ctx.Err() != nil {
+			return s.fail(s.ctx.Err())
+		}
+		if s.reconnects >= s.opt.MaxReconnects {
+			return s.fail(fmt.Errorf("subscription stream terminated before the final data: %w", err))
+		}
+		if s.cli.logger != nil {
+			s.cli.logger.Infof("subscription interrupted, reconnect(%d/%d): %v", s.reconnects+1, s.opt.MaxReconnects, err)
+		}
+		s.reconnects++
+		select {
+		case <-s.ctx.Done():
+			return s.fail(s.ctx.Err())
+		case <-time.After(s.opt.ReconnectInterval):
+		}
+	}
+	return false
+}
+
+// Data returns the current data
+func (s *Subscription[DATA]) Data() DATA {
+	return s.current
+}
+
+// Err returns the error which terminated the stream, nil if the stream is terminated cleanly
+func (s *Subscription[DATA]) Err() error {
+	return s.err
+}
+
+// Close terminates the stream, it should not be called concurrently with Next, cancel the context instead
+func (s *Subscription[DATA]) Close() {
+	s.closeBody()
+	s.done = true
+}
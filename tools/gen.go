@@ -235,29 +235,26 @@ func (g generator) genInputObjects() {
 	}
 }
 
-func (g generator) genQueryParameters() {
-	g.w.Out("// ====================\n// QueryArgumentObjects \n// --------------------\n\n")
-	for {
-		queryType, has := g.schema.Types["Query"]
-		if !has {
-			break
-		}
-		queryObject, is := queryType.(*types.ObjectTypeDefinition)
-		if !is {
-			break
-		}
-		for _, field := range queryObject.Fields {
-			g.w.Out("type Query%sParams struct {\n", util.UpperFirst(field.Name))
-			for _, arg := range field.Arguments {
-				goFieldName := util.UpperFirst(arg.Name.Name)
-				tags := fmt.Sprintf(`name:"%s" kind:"%s"`, arg.Name.Name, unwrapType(arg.Type).Kind())
-				g.w.OutLines(arg.Desc, "\t// ")
-				g.w.Out("\t// SCHEMA: %s %s\n", arg.Name.Name, arg.Type.String())
-				g.w.Out("\t%s %s `%s`\n", goFieldName, convertToGoType(arg.Type, false), tags)
-			}
-			g.w.Out("}\n\n")
+func (g generator) genOperationParameters(operation string) {
+	g.w.Out("// ====================\n// %sArgumentObjects \n// --------------------\n\n", operation)
+	operationType, has := g.schema.Types[operation]
+	if !has {
+		return
+	}
+	operationObject, is := operationType.(*types.ObjectTypeDefinition)
+	if !is {
+		return
+	}
+	for _, field := range operationObject.Fields {
+		g.w.Out("type %s%sParams struct {\n", operation, util.UpperFirst(field.Name))
+		for _, arg := range field.Arguments {
+			goFieldName := util.UpperFirst(arg.Name.Name)
+			tags := fmt.Sprintf(`name:"%s" kind:"%s"`, arg.Name.Name, unwrapType(arg.Type).Kind())
+			g.w.OutLines(arg.Desc, "\t// ")
+			g.w.Out("\t// SCHEMA: %s %s\n", arg.Name.Name, arg.Type.String())
+			g.w.Out("\t%s %s `%s`\n", goFieldName, convertToGoType(arg.Type, false), tags)
 		}
-		break
+		g.w.Out("}\n\n")
 	}
 }
 
@@ -269,7 +266,9 @@ func (g generator) Gen() {
 	g.genObjects()
 	g.genUnions()
 	g.genInputObjects()
-	g.genQueryParameters()
+	g.genOperationParameters("Query")
+	g.genOperationParameters("Mutation")
+	g.genOperationParameters("Subscription")
 }
 
 func main() {
@@ -0,0 +1,145 @@
+package types
+
+import (
+	"fmt"
+)
+
+// CallFrame is a frame of the call stack of a script, the root frame is the script itself
+type CallFrame struct {
+	// The CALL receipt created the frame, nil for the root frame
+	Call *CallReceipt
+	// Zero if the caller is the script
+	Caller ContractId
+	// Zero for the root frame
+	Contract ContractId
+	// The forwarded coins and gas of the call
+	AssetId AssetId
+	Amount  U64
+	Gas     U64
+	// The LOG and LOG_DATA receipts emitted in the frame
+	Logs []TypedReceipt
+	// All the receipts emitted in the frame except the CALL ones and the one ended the frame
+	Receipts []TypedReceipt
+	Calls    []*CallFrame
+	// One of ReturnReceipt, ReturnDataReceipt, PanicReceipt and RevertReceipt,
+	// nil if the frame is unwound by a failure in an inner frame or the receipts are incomplete
+	End TypedReceipt
+	// The frame is terminated by a PANIC or REVERT in an inner frame
+	Unwound bool
+}
+
+// Failed returns true if the frame is ended by a PANIC or REVERT receipt
+func (f *CallFrame) Failed() bool {
+	switch f.End.(type) {
+	case PanicReceipt, RevertReceipt:
+		return true
+	}
+	return false
+}
+
+type CallTree struct {
+	Root *CallFrame
+	// nil if there is no SCRIPT_RESULT receipt
+	Result *ScriptResultReceipt
+}
+
+// FailedFrame returns the frame ended by a PANIC or REVERT receipt, nil if there is no such frame
+func (t *CallTree) FailedFrame() *CallFrame {
+	var find func(f *CallFrame) *CallFrame
+	find = func(f *CallFrame) *CallFrame {
+		if f.Failed() {
+			return f
+		}
+		for _, child := range f.Calls {
+			if failed := find(child); failed != nil {
+				return failed
+			}
+		}
+		return nil
+	}
+	return find(t.Root)
+}
+
+// BuildCallTree rebuilds the call stack of a transaction from its receipts,
+// such as SuccessStatus.Receipts and FailureStatus.Receipts
+func BuildCallTree(receipts []Receipt) (*CallTree, error) {
+	typed, err := DecodeReceipts(receipts)
+	if err != nil {
+		return nil, err
+	}
+	tree := &CallTree{Root: &CallFrame{}}
+	stack := []*CallFrame{tree.Root}
+	for i, receipt := range typed {
+		if tree.Result != nil {
+			return nil, fmt.Errorf("unexpected %s receipt %d after SCRIPT_RESULT", receipt.Type(), i)
+		}
+		if result, is := receipt.(ScriptResultReceipt); is {
+			tree.Result = &result
+			continue
+		}
+		if len(stack) == 0 {
+			return nil, fmt.Errorf("unexpected %s receipt %d after the script ended", receipt.Type(), i)
+		}
+		top := stack[len(stack)-1]
+		var id ContractId
+		switch r := receipt.(type) {
+		case CallReceipt:
+			id = r.Id
+		case ReturnReceipt:
+			id = r.Id
+		case ReturnDataReceipt:
+			id = r.Id
+		case PanicReceipt:
+			id = r.Id
+		case RevertReceipt:
+			id = r.Id
+		case LogReceipt:
+			id = r.Id
+		case LogDataReceipt:
+			id = r.Id
+		case TransferReceipt:
+			id = r.Id
+		case TransferOutReceipt:
+			id = r.Id
+		case MintReceipt:
+			id = r.ContractId
+		case BurnReceipt:
+			id = r.ContractId
+		default:
+			id = top.Contract
+		}
+		if id != top.Contract {
+			return nil, fmt.Errorf("%s receipt %d is from %s while the current frame is %s",
+				receipt.Type(), i, id, top.Contract)
+		}
+		switch r := receipt.(type) {
+		case CallReceipt:
+			frame := &CallFrame{
+				Call:     &r,
+				Caller:   r.Id,
+				Contract: r.To,
+				AssetId:  r.AssetId,
+				Amount:   r.Amount,
+				Gas:      r.Gas,
+			}
+			top.Calls = append(top.Calls, frame)
+			stack = append(stack, frame)
+		case ReturnReceipt, ReturnDataReceipt:
+			top.End = receipt
+			stack = stack[:len(stack)-1]
+		case PanicReceipt, RevertReceipt:
+			// the failure terminates the whole script
+			top.End = receipt
+			for _, frame := range stack[:len(stack)-1] {
+				frame.Unwound = true
+			}
+			stack = nil
+		case LogReceipt, LogDataReceipt:
+			top.Logs = append(top.Logs, receipt)
+			top.Receipts = append(top.Receipts, receipt)
+		default:
+			top.Receipts = append(top.Receipts, receipt)
+		}
+	}
+	return tree, nil
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func testContractId(b byte) ContractId {
+	return ContractId{Hash: common.BytesToHash([]byte{b})}
+}
+
+// testReceipts builds the receipts from the lines like "CALL 0 1", which are the receipt type and the ids,
+// CALL needs the caller and callee, SCRIPT_RESULT needs the result and the others need the contract id
+func testReceipts(t *testing.T, lines ...string) []Receipt {
+	var raw []string
+	for _, line := range lines {
+		parts := strings.Fields(line)
+		id := func(i int) string {
+			var b byte
+			_, _ = fmt.Sscan(parts[i], &b)
+			return testContractId(b).String()
+		}
+		var fields string
+		switch parts[0] {
+		case "CALL":
+			fields = fmt.Sprintf(`"id": %q, "to": %q, "amount": "10", "assetId": %q, "gas": "1000", "param1": "0", "param2": "0"`,
+				id(1), id(2), common.Hash{}.String())
+		case "RETURN":
+			fields = fmt.Sprintf(`"id": %q, "val": "1"`, id(1))
+		case "PANIC":
+			fields = fmt.Sprintf(`"id": %q, "reason": "1"`, id(1))
+		case "REVERT":
+			fields = fmt.Sprintf(`"id": %q, "ra": "0"`, id(1))
+		case "LOG":
+			fields = fmt.Sprintf(`"id": %q, "ra": "1", "rb": "2", "rc": "0", "rd": "0"`, id(1))
+		case "SCRIPT_RESULT":
+			fields = fmt.Sprintf(`"result": %q, "gasUsed": "100"`, parts[1])
+		}
+		if parts[0] != "SCRIPT_RESULT" {
+			fields += `, "pc": "0", "is": "0"`
+		}
+		raw = append(raw, fmt.Sprintf(`{"receiptType": %q, %s}`, parts[0], fields))
+	}
+	var receipts []Receipt
+	assert.NoError(t, json.Unmarshal([]byte("["+strings.Join(raw, ",")+"]"), &receipts))
+	return receipts
+}
+
+func Test_BuildCallTree(t *testing.T) {
+	tree, err := BuildCallTree(testReceipts(t,
+		"CALL 0 1",
+		"LOG 1",
+		"CALL 1 2",
+		"RETURN 2",
+		"CALL 1 3",
+		"LOG 3",
+		"REVERT 3",
+		"SCRIPT_RESULT 1",
+	))
+	assert.NoError(t, err)
+	assert.Equal(t, ScriptResultReceipt{Result: 1, GasUsed: 100}, *tree.Result)
+
+	root := tree.Root
+	assert.Nil(t, root.Call)
+	assert.True(t, root.Unwound)
+	assert.Nil(t, root.End)
+	assert.Len(t, root.Calls, 1)
+
+	a := root.Calls[0]
+	assert.Equal(t, ContractId{}, a.Caller)
+	assert.Equal(t, testContractId(1), a.Contract)
+	assert.Equal(t, U64(10), a.Amount)
+	assert.Equal(t, U64(1000), a.Gas)
+	assert.Len(t, a.Logs, 1)
+	assert.True(t, a.Unwound)
+	assert.False(t, a.Failed())
+	assert.Len(t, a.Calls, 2)
+
+	b, c := a.Calls[0], a.Calls[1]
+	assert.Equal(t, testContractId(1), b.Caller)
+	assert.Equal(t, ReturnReceipt{Id: testContractId(2), Val: 1}, b.End)
+	assert.False(t, b.Unwound)
+	assert.Equal(t, testContractId(3), c.Contract)
+	assert.Len(t, c.Logs, 1)
+	assert.True(t, c.Failed())
+	assert.Same(t, c, tree.FailedFrame())
+
+	tree, err = BuildCallTree(testReceipts(t, "CALL 0 1", "RETURN 1", "RETURN 0", "SCRIPT_RESULT 0"))
+	assert.NoError(t, err)
+	assert.Equal(t, ReturnReceipt{Val: 1}, tree.Root.End)
+	assert.Nil(t, tree.FailedFrame())
+
+	tree, err = BuildCallTree(testReceipts(t, "PANIC 0", "SCRIPT_RESULT 2"))
+	assert.NoError(t, err)
+	assert.Same(t, tree.Root, tree.FailedFrame())
+}
+
+func Test_BuildCallTreeMalformed(t *testing.T) {
+	_, err := BuildCallTree(testReceipts(t, "CALL 0 1", "RETURN 2"))
+	assert.EqualError(t, err, fmt.Sprintf("RETURN receipt 1 is from %s while the current frame is %s",
+		testContractId(2), testContractId(1)))
+
+	_, err = BuildCallTree(testReceipts(t, "RETURN 0", "LOG 0"))
+	assert.EqualError(t, err, "unexpected LOG receipt 1 after the script ended")
+
+	_, err = BuildCallTree(testReceipts(t, "RETURN 0", "SCRIPT_RESULT 0", "SCRIPT_RESULT 0"))
+	assert.EqualError(t, err, "unexpected SCRIPT_RESULT receipt 2 after SCRIPT_RESULT")
+}
@@ -0,0 +1,252 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PanicReason is the reason code of a VM panic, the values are defined by fuel-asm
+type PanicReason uint8
+
+const (
+	PanicReasonUnknown PanicReason = iota
+	PanicReasonRevert
+	PanicReasonOutOfGas
+	PanicReasonTransactionValidity
+	PanicReasonMemoryOverflow
+	PanicReasonArithmeticOverflow
+	PanicReasonContractNotFound
+	PanicReasonMemoryOwnership
+	PanicReasonNotEnoughBalance
+	PanicReasonExpectedInternalContext
+	PanicReasonAssetIdNotFound
+	PanicReasonInputNotFound
+	PanicReasonOutputNotFound
+	PanicReasonWitnessNotFound
+	PanicReasonTransactionMaturity
+	PanicReasonInvalidMetadataIdentifier
+	PanicReasonMalformedCallStructure
+	PanicReasonReservedRegisterNotWritable
+	PanicReasonInvalidFlags
+	PanicReasonInvalidImmediateValue
+	PanicReasonExpectedCoinInput
+	PanicReasonEcalError
+	PanicReasonMemoryWriteOverlap
+	PanicReasonContractNotInInputs
+	PanicReasonInternalBalanceOverflow
+	PanicReasonContractMaxSize
+	PanicReasonExpectedUnallocatedStack
+	PanicReasonMaxStaticContractsReached
+	PanicReasonTransferAmountCannotBeZero
+	PanicReasonExpectedOutputVariable
+	PanicReasonExpectedParentInternalContext
+	PanicReasonPredicateReturnedNonOne
+	PanicReasonContractIdAlreadyDeployed
+	PanicReasonContractMismatch
+	PanicReasonMessageDataTooLong
+	PanicReasonArithmeticError
+	PanicReasonContractInstructionNotAllowed
+	PanicReasonTransferZeroCoins
+	PanicReasonInvalidInstruction
+	PanicReasonMemoryNotExecutable
+	PanicReasonPolicyIsNotSet
+	PanicReasonPolicyNotFound
+	PanicReasonTooManyReceipts
+	PanicReasonBalanceOverflow
+	PanicReasonInvalidBlockHeight
+	PanicReasonTooManySlots
+	PanicReasonExpectedNestedCaller
+	PanicReasonMemoryGrowthOverlap
+	PanicReasonUninitalizedMemoryAccess
+	PanicReasonOverridingConsensusParameters
+	PanicReasonUnknownStateTransactionBytecodeRoot
+	PanicReasonOverridingStateTransactionBytecode
+	PanicReasonBytecodeAlreadyUploaded
+	PanicReasonThePartIsNotSequentiallyConnected
+	PanicReasonBlobNotFound
+	PanicReasonBlobIdAlreadyUploaded
+	PanicReasonGasCostNotDefined
+)
+
+var panicReasonNames = []string{
+	"UnknownPanicReason",
+	"Revert",
+	"OutOfGas",
+	"TransactionValidity",
+	"MemoryOverflow",
+	"ArithmeticOverflow",
+	"ContractNotFound",
+	"MemoryOwnership",
+	"NotEnoughBalance",
+	"ExpectedInternalContext",
+	"AssetIdNotFound",
+	"InputNotFound",
+	"OutputNotFound",
+	"WitnessNotFound",
+	"TransactionMaturity",
+	"InvalidMetadataIdentifier",
+	"MalformedCallStructure",
+	"ReservedRegisterNotWritable",
+	"InvalidFlags",
+	"InvalidImmediateValue",
+	"ExpectedCoinInput",
+	"EcalError",
+	"MemoryWriteOverlap",
+	"ContractNotInInputs",
+	"InternalBalanceOverflow",
+	"ContractMaxSize",
+	"ExpectedUnallocatedStack",
+	"MaxStaticContractsReached",
+	"TransferAmountCannotBeZero",
+	"ExpectedOutputVariable",
+	"ExpectedParentInternalContext",
+	"PredicateReturnedNonOne",
+	"ContractIdAlreadyDeployed",
+	"ContractMismatch",
+	"MessageDataTooLong",
+	"ArithmeticError",
+	"ContractInstructionNotAllowed",
+	"TransferZeroCoins",
+	"InvalidInstruction",
+	"MemoryNotExecutable",
+	"PolicyIsNotSet",
+	"PolicyNotFound",
+	"TooManyReceipts",
+	"BalanceOverflow",
+	"InvalidBlockHeight",
+	"TooManySlots",
+	"ExpectedNestedCaller",
+	"MemoryGrowthOverlap",
+	"UninitalizedMemoryAccess",
+	"OverridingConsensusParameters",
+	"UnknownStateTransactionBytecodeRoot",
+	"OverridingStateTransactionBytecode",
+	"BytecodeAlreadyUploaded",
+	"ThePartIsNotSequentiallyConnected",
+	"BlobNotFound",
+	"BlobIdAlreadyUploaded",
+	"GasCostNotDefined",
+}
+
+func (r PanicReason) String() string {
+	if int(r) < len(panicReasonNames) {
+		return panicReasonNames[r]
+	}
+	return fmt.Sprintf("PanicReason(0x%02x)", uint8(r))
+}
+
+// PanicInstruction is the reason of a VM panic together with the instruction caused it
+type PanicInstruction struct {
+	Reason PanicReason
+	// The raw instruction word
+	Instruction uint32
+}
+
+// DecodePanicReason unpacks the Reason of a PANIC receipt,
+// the highest 8 bits are the reason code and the following 32 bits are the instruction
+func DecodePanicReason(reason U64) PanicInstruction {
+	return PanicInstruction{
+		Reason:      PanicReason(reason >> 56),
+		Instruction: uint32(reason >> 24),
+	}
+}
+
+func (p PanicInstruction) Error() string {
+	return fmt.Sprintf("panic %s at instruction 0x%08x", p.Reason, p.Instruction)
+}
+
+// The revert codes used by the Sway standard library to signal the failures
+const (
+	RevertFailedRequire           U64 = 0xffff_ffff_ffff_0000
+	RevertFailedTransferToAddress U64 = 0xffff_ffff_ffff_0001
+	RevertFailedSendMessage       U64 = 0xffff_ffff_ffff_0002
+	RevertFailedAssertEq          U64 = 0xffff_ffff_ffff_0003
+	RevertFailedAssert            U64 = 0xffff_ffff_ffff_0004
+	RevertFailedAssertNe          U64 = 0xffff_ffff_ffff_0005
+	RevertWithLog                 U64 = 0xffff_ffff_ffff_0006
+)
+
+var revertSignals = map[U64]string{
+	RevertFailedRequire:           "failed require",
+	RevertFailedTransferToAddress: "failed transfer to address",
+	RevertFailedSendMessage:       "failed send message",
+	RevertFailedAssertEq:          "failed assert_eq",
+	RevertFailedAssert:            "failed assert",
+	RevertFailedAssertNe:          "failed assert_ne",
+	RevertWithLog:                 "revert with log",
+}
+
+// RevertError is the revert code of a REVERT receipt
+type RevertError struct {
+	Code U64
+	// Description of the Sway signal, empty if the code is not a known signal
+	Signal string
+}
+
+// DecodeRevert maps the Ra of a REVERT receipt to the known Sway signals
+func DecodeRevert(ra U64) RevertError {
+	return RevertError{Code: ra, Signal: revertSignals[ra]}
+}
+
+func (e RevertError) Error() string {
+	if e.Signal != "" {
+		return fmt.Sprintf("revert: %s", e.Signal)
+	}
+	return fmt.Sprintf("revert with code %d", e.Code)
+}
+
+// FailureExplanation tells why a transaction failed
+type FailureExplanation struct {
+	// The reason given by the node
+	Reason String
+	// The frame failed, nil if the receipts do not contain a PANIC or REVERT receipt
+	Frame *CallFrame
+	// One of PanicInstruction and RevertError, nil if Frame is nil
+	Cause error
+}
+
+// Contract returns the contract failed, which is zero if the failure happened in the script
+func (e FailureExplanation) Contract() ContractId {
+	if e.Frame == nil {
+		return ContractId{}
+	}
+	return e.Frame.Contract
+}
+
+func (e FailureExplanation) String() string {
+	if e.Cause == nil {
+		return string(e.Reason)
+	}
+	var buf strings.Builder
+	if e.Contract() == (ContractId{}) {
+		buf.WriteString("script")
+	} else {
+		buf.WriteString("contract ")
+		buf.WriteString(e.Contract().String())
+	}
+	buf.WriteString(" failed: ")
+	buf.WriteString(e.Cause.Error())
+	if len(e.Frame.Logs) > 0 {
+		fmt.Fprintf(&buf, " (%d logs emitted before the failure)", len(e.Frame.Logs))
+	}
+	return buf.String()
+}
+
+// Explain finds the frame failed in the receipts and decodes the cause,
+// the receipts should be selected in the query
+func (s FailureStatus) Explain() (FailureExplanation, error) {
+	explanation := FailureExplanation{Reason: s.Reason}
+	tree, err := BuildCallTree(s.Receipts)
+	if err != nil {
+		return explanation, err
+	}
+	if explanation.Frame = tree.FailedFrame(); explanation.Frame != nil {
+		switch end := explanation.Frame.End.(type) {
+		case PanicReceipt:
+			explanation.Cause = DecodePanicReason(end.Reason)
+		case RevertReceipt:
+			explanation.Cause = DecodeRevert(end.Ra)
+		}
+	}
+	return explanation, nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_DecodePanicReason(t *testing.T) {
+	panicked := DecodePanicReason(U64(0x06)<<56 | U64(0x5d4c9040)<<24)
+	assert.Equal(t, PanicInstruction{Reason: PanicReasonContractNotFound, Instruction: 0x5d4c9040}, panicked)
+	assert.EqualError(t, panicked, "panic ContractNotFound at instruction 0x5d4c9040")
+
+	assert.Equal(t, "GasCostNotDefined", PanicReasonGasCostNotDefined.String())
+	assert.Equal(t, "PanicReason(0xff)", PanicReason(0xff).String())
+}
+
+func Test_DecodeRevert(t *testing.T) {
+	assert.EqualError(t, DecodeRevert(0xffff_ffff_ffff_0000), "revert: failed require")
+	assert.EqualError(t, DecodeRevert(0xffff_ffff_ffff_0003), "revert: failed assert_eq")
+	assert.EqualError(t, DecodeRevert(RevertFailedTransferToAddress), "revert: failed transfer to address")
+	assert.Equal(t, RevertError{Code: 42}, DecodeRevert(42))
+	assert.EqualError(t, DecodeRevert(42), "revert with code 42")
+}
+
+func Test_FailureStatusExplain(t *testing.T) {
+	status := FailureStatus{
+		Reason:   "Revert(18446744073709486080)",
+		Receipts: testReceipts(t, "CALL 0 1", "LOG 1", "REVERT 1", "SCRIPT_RESULT 1"),
+	}
+	ra := RevertFailedRequire
+	status.Receipts[2].Ra = &ra
+	explanation, err := status.Explain()
+	assert.NoError(t, err)
+	assert.Equal(t, testContractId(1), explanation.Contract())
+	assert.Equal(t, RevertError{Code: RevertFailedRequire, Signal: "failed require"}, explanation.Cause)
+	assert.Equal(t, fmt.Sprintf("contract %s failed: revert: failed require (1 logs emitted before the failure)",
+		testContractId(1)), explanation.String())
+
+	status = FailureStatus{
+		Reason:   "OutOfGas",
+		Receipts: testReceipts(t, "PANIC 0", "SCRIPT_RESULT 2"),
+	}
+	reason := U64(PanicReasonOutOfGas)<<56 | U64(0x12345678)<<24
+	status.Receipts[0].Reason = &reason
+	explanation, err = status.Explain()
+	assert.NoError(t, err)
+	assert.Equal(t, ContractId{}, explanation.Contract())
+	assert.Equal(t, "script failed: panic OutOfGas at instruction 0x12345678", explanation.String())
+
+	explanation, err = FailureStatus{Reason: "Predicate failed"}.Explain()
+	assert.NoError(t, err)
+	assert.Nil(t, explanation.Frame)
+	assert.Equal(t, "Predicate failed", explanation.String())
+
+	_, err = FailureStatus{Receipts: testReceipts(t, "RETURN 1")}.Explain()
+	assert.Error(t, err)
+}
@@ -0,0 +1,314 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TypedReceipt is one of the concrete receipt types decoded from Receipt by Receipt.Decode
+type TypedReceipt interface {
+	Type() ReceiptType
+}
+
+// CallReceipt is created when a contract is called, Id is the caller, which is zero if it is the script
+type CallReceipt struct {
+	Id      ContractId
+	To      ContractId
+	Amount  U64
+	AssetId AssetId
+	Gas     U64
+	Param1  U64
+	Param2  U64
+	Pc      U64
+	Is      U64
+}
+
+type ReturnReceipt struct {
+	Id  ContractId
+	Val U64
+	Pc  U64
+	Is  U64
+}
+
+// ReturnDataReceipt carries the returned memory, Data is empty if the node did not provide it
+type ReturnDataReceipt struct {
+	Id     ContractId
+	Ptr    U64
+	Len    U64
+	Digest Bytes32
+	Data   HexString
+	Pc     U64
+	Is     U64
+}
+
+// PanicReceipt is created when the VM panics, ContractId is set if the panic is caused by a missing contract input
+type PanicReceipt struct {
+	Id         ContractId
+	Reason     U64
+	Pc         U64
+	Is         U64
+	ContractId *ContractId
+}
+
+type RevertReceipt struct {
+	Id ContractId
+	Ra U64
+	Pc U64
+	Is U64
+}
+
+type LogReceipt struct {
+	Id ContractId
+	Ra U64
+	Rb U64
+	Rc U64
+	Rd U64
+	Pc U64
+	Is U64
+}
+
+// LogDataReceipt carries the logged memory, Data is empty if the node did not provide it
+type LogDataReceipt struct {
+	Id     ContractId
+	Ra     U64
+	Rb     U64
+	Ptr    U64
+	Len    U64
+	Digest Bytes32
+	Data   HexString
+	Pc     U64
+	Is     U64
+}
+
+// TransferReceipt is created when coins are transferred to a contract
+type TransferReceipt struct {
+	Id      ContractId
+	To      ContractId
+	Amount  U64
+	AssetId AssetId
+	Pc      U64
+	Is      U64
+}
+
+// TransferOutReceipt is created when coins are transferred to an address
+type TransferOutReceipt struct {
+	Id        ContractId
+	ToAddress Address
+	Amount    U64
+	AssetId   AssetId
+	Pc        U64
+	Is        U64
+}
+
+type ScriptResultReceipt struct {
+	Result  U64
+	GasUsed U64
+}
+
+// MessageOutReceipt is created when a message is sent to the layer 1
+type MessageOutReceipt struct {
+	Sender    Address
+	Recipient Address
+	Amount    U64
+	Nonce     Nonce
+	Len       U64
+	Digest    Bytes32
+	Data      HexString
+}
+
+type MintReceipt struct {
+	SubId      Bytes32
+	ContractId ContractId
+	Val        U64
+	Pc         U64
+	Is         U64
+}
+
+type BurnReceipt struct {
+	SubId      Bytes32
+	ContractId ContractId
+	Val        U64
+	Pc         U64
+	Is         U64
+}
+
+func (CallReceipt) Type() ReceiptType         { return "CALL" }
+func (ReturnReceipt) Type() ReceiptType       { return "RETURN" }
+func (ReturnDataReceipt) Type() ReceiptType   { return "RETURN_DATA" }
+func (PanicReceipt) Type() ReceiptType        { return "PANIC" }
+func (RevertReceipt) Type() ReceiptType       { return "REVERT" }
+func (LogReceipt) Type() ReceiptType          { return "LOG" }
+func (LogDataReceipt) Type() ReceiptType      { return "LOG_DATA" }
+func (TransferReceipt) Type() ReceiptType     { return "TRANSFER" }
+func (TransferOutReceipt) Type() ReceiptType  { return "TRANSFER_OUT" }
+func (ScriptResultReceipt) Type() ReceiptType { return "SCRIPT_RESULT" }
+func (MessageOutReceipt) Type() ReceiptType   { return "MESSAGE_OUT" }
+func (MintReceipt) Type() ReceiptType         { return "MINT" }
+func (BurnReceipt) Type() ReceiptType         { return "BURN" }
+
+// receiptReader collects the names of the missing required fields
+type receiptReader struct {
+	missing []string
+}
+
+func required[T any](r *receiptReader, name string, value *T) T {
+	if value == nil {
+		r.missing = append(r.missing, name)
+		var zero T
+		return zero
+	}
+	return *value
+}
+
+func optionalData(value *HexString) HexString {
+	if value == nil {
+		return HexString{}
+	}
+	return *value
+}
+
+// Decode converts the receipt to the concrete type according to the ReceiptType,
+// an error will be returned if any field required by the type is missing
+func (r Receipt) Decode() (TypedReceipt, error) {
+	var rd receiptReader
+	var typed TypedReceipt
+	switch r.ReceiptType {
+	case "CALL":
+		typed = CallReceipt{
+			Id:      required(&rd, "id", r.Id),
+			To:      required(&rd, "to", r.To),
+			Amount:  required(&rd, "amount", r.Amount),
+			AssetId: required(&rd, "assetId", r.AssetId),
+			Gas:     required(&rd, "gas", r.Gas),
+			Param1:  required(&rd, "param1", r.Param1),
+			Param2:  required(&rd, "param2", r.Param2),
+			Pc:      required(&rd, "pc", r.Pc),
+			Is:      required(&rd, "is", r.Is),
+		}
+	case "RETURN":
+		typed = ReturnReceipt{
+			Id:  required(&rd, "id", r.Id),
+			Val: required(&rd, "val", r.Val),
+			Pc:  required(&rd, "pc", r.Pc),
+			Is:  required(&rd, "is", r.Is),
+		}
+	case "RETURN_DATA":
+		typed = ReturnDataReceipt{
+			Id:     required(&rd, "id", r.Id),
+			Ptr:    required(&rd, "ptr", r.Ptr),
+			Len:    required(&rd, "len", r.Len),
+			Digest: required(&rd, "digest", r.Digest),
+			Data:   optionalData(r.Data),
+			Pc:     required(&rd, "pc", r.Pc),
+			Is:     required(&rd, "is", r.Is),
+		}
+	case "PANIC":
+		typed = PanicReceipt{
+			Id:         required(&rd, "id", r.Id),
+			Reason:     required(&rd, "reason", r.Reason),
+			Pc:         required(&rd, "pc", r.Pc),
+			Is:         required(&rd, "is", r.Is),
+			ContractId: r.ContractId,
+		}
+	case "REVERT":
+		typed = RevertReceipt{
+			Id: required(&rd, "id", r.Id),
+			Ra: required(&rd, "ra", r.Ra),
+			Pc: required(&rd, "pc", r.Pc),
+			Is: required(&rd, "is", r.Is),
+		}
+	case "LOG":
+		typed = LogReceipt{
+			Id: required(&rd, "id", r.Id),
+			Ra: required(&rd, "ra", r.Ra),
+			Rb: required(&rd, "rb", r.Rb),
+			Rc: required(&rd, "rc", r.Rc),
+			Rd: required(&rd, "rd", r.Rd),
+			Pc: required(&rd, "pc", r.Pc),
+			Is: required(&rd, "is", r.Is),
+		}
+	case "LOG_DATA":
+		typed = LogDataReceipt{
+			Id:     required(&rd, "id", r.Id),
+			Ra:     required(&rd, "ra", r.Ra),
+			Rb:     required(&rd, "rb", r.Rb),
+			Ptr:    required(&rd, "ptr", r.Ptr),
+			Len:    required(&rd, "len", r.Len),
+			Digest: required(&rd, "digest", r.Digest),
+			Data:   optionalData(r.Data),
+			Pc:     required(&rd, "pc", r.Pc),
+			Is:     required(&rd, "is", r.Is),
+		}
+	case "TRANSFER":
+		typed = TransferReceipt{
+			Id:      required(&rd, "id", r.Id),
+			To:      required(&rd, "to", r.To),
+			Amount:  required(&rd, "amount", r.Amount),
+			AssetId: required(&rd, "assetId", r.AssetId),
+			Pc:      required(&rd, "pc", r.Pc),
+			Is:      required(&rd, "is", r.Is),
+		}
+	case "TRANSFER_OUT":
+		typed = TransferOutReceipt{
+			Id:        required(&rd, "id", r.Id),
+			ToAddress: required(&rd, "toAddress", r.ToAddress),
+			Amount:    required(&rd, "amount", r.Amount),
+			AssetId:   required(&rd, "assetId", r.AssetId),
+			Pc:        required(&rd, "pc", r.Pc),
+			Is:        required(&rd, "is", r.Is),
+		}
+	case "SCRIPT_RESULT":
+		typed = ScriptResultReceipt{
+			Result:  required(&rd, "result", r.Result),
+			GasUsed: required(&rd, "gasUsed", r.GasUsed),
+		}
+	case "MESSAGE_OUT":
+		typed = MessageOutReceipt{
+			Sender:    required(&rd, "sender", r.Sender),
+			Recipient: required(&rd, "recipient", r.Recipient),
+			Amount:    required(&rd, "amount", r.Amount),
+			Nonce:     required(&rd, "nonce", r.Nonce),
+			Len:       required(&rd, "len", r.Len),
+			Digest:    required(&rd, "digest", r.Digest),
+			Data:      optionalData(r.Data),
+		}
+	case "MINT", "BURN":
+		// the minting contract is given by contractId, and also by id in some node versions
+		contractId := r.ContractId
+		if contractId == nil {
+			contractId = r.Id
+		}
+		subId := required(&rd, "subId", r.SubId)
+		id := required(&rd, "contractId", contractId)
+		val := required(&rd, "val", r.Val)
+		pc := required(&rd, "pc", r.Pc)
+		is := required(&rd, "is", r.Is)
+		if r.ReceiptType == "MINT" {
+			typed = MintReceipt{SubId: subId, ContractId: id, Val: val, Pc: pc, Is: is}
+		} else {
+			typed = BurnReceipt{SubId: subId, ContractId: id, Val: val, Pc: pc, Is: is}
+		}
+	default:
+		return nil, fmt.Errorf("unknown receipt type %q", r.ReceiptType)
+	}
+	if len(rd.missing) > 0 {
+		return nil, fmt.Errorf("malformed %s receipt: missing %s", r.ReceiptType, strings.Join(rd.missing, ", "))
+	}
+	if r.Data != nil && r.Len != nil && uint64(len(r.Data.Bytes)) != uint64(*r.Len) {
+		return nil, fmt.Errorf("malformed %s receipt: data has %d bytes but len is %d",
+			r.ReceiptType, len(r.Data.Bytes), *r.Len)
+	}
+	return typed, nil
+}
+
+// DecodeReceipts decodes all the receipts by Receipt.Decode
+func DecodeReceipts(receipts []Receipt) ([]TypedReceipt, error) {
+	typed := make([]TypedReceipt, len(receipts))
+	for i, receipt := range receipts {
+		var err error
+		if typed[i], err = receipt.Decode(); err != nil {
+			return nil, fmt.Errorf("decode receipt %d failed: %w", i, err)
+		}
+	}
+	return typed, nil
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_ReceiptDecode(t *testing.T) {
+	var receipts []Receipt
+	err := json.Unmarshal([]byte(`
+[{
+	"receiptType": "CALL",
+	"id": "0x0000000000000000000000000000000000000000000000000000000000000000",
+	"to": "0x0101010101010101010101010101010101010101010101010101010101010101",
+	"amount": "100",
+	"assetId": "0x0202020202020202020202020202020202020202020202020202020202020202",
+	"gas": "50000",
+	"param1": "1",
+	"param2": "2",
+	"pc": "11712",
+	"is": "11712"
+},{
+	"receiptType": "LOG_DATA",
+	"id": "0x0101010101010101010101010101010101010101010101010101010101010101",
+	"ra": "0",
+	"rb": "7",
+	"ptr": "1024",
+	"len": "2",
+	"digest": "0x0303030303030303030303030303030303030303030303030303030303030303",
+	"data": "0xabcd",
+	"pc": "12000",
+	"is": "11712"
+},{
+	"receiptType": "PANIC",
+	"id": "0x0101010101010101010101010101010101010101010101010101010101010101",
+	"reason": "123",
+	"pc": "12004",
+	"is": "11712",
+	"contractId": null
+},{
+	"receiptType": "MINT",
+	"subId": "0x0404040404040404040404040404040404040404040404040404040404040404",
+	"id": "0x0101010101010101010101010101010101010101010101010101010101010101",
+	"val": "5",
+	"pc": "12008",
+	"is": "11712"
+},{
+	"receiptType": "SCRIPT_RESULT",
+	"result": "2",
+	"gasUsed": "30000"
+}]
+`), &receipts)
+	assert.NoError(t, err)
+
+	typed, err := DecodeReceipts(receipts)
+	assert.NoError(t, err)
+	contract := ContractId{Hash: common.HexToHash("0x0101010101010101010101010101010101010101010101010101010101010101")}
+	assert.Equal(t, []TypedReceipt{
+		CallReceipt{
+			To:      contract,
+			Amount:  100,
+			AssetId: AssetId{Hash: common.HexToHash("0x0202020202020202020202020202020202020202020202020202020202020202")},
+			Gas:     50000,
+			Param1:  1,
+			Param2:  2,
+			Pc:      11712,
+			Is:      11712,
+		},
+		LogDataReceipt{
+			Id:     contract,
+			Rb:     7,
+			Ptr:    1024,
+			Len:    2,
+			Digest: Bytes32{Hash: common.HexToHash("0x0303030303030303030303030303030303030303030303030303030303030303")},
+			Data:   HexString{Bytes: []byte{0xab, 0xcd}},
+			Pc:     12000,
+			Is:     11712,
+		},
+		PanicReceipt{Id: contract, Reason: 123, Pc: 12004, Is: 11712},
+		MintReceipt{
+			SubId:      Bytes32{Hash: common.HexToHash("0x0404040404040404040404040404040404040404040404040404040404040404")},
+			ContractId: contract,
+			Val:        5,
+			Pc:         12008,
+			Is:         11712,
+		},
+		ScriptResultReceipt{Result: 2, GasUsed: 30000},
+	}, typed)
+	assert.Equal(t, ReceiptType("LOG_DATA"), typed[1].Type())
+}
+
+func Test_ReceiptDecodeMalformed(t *testing.T) {
+	val := U64(1)
+	_, err := Receipt{ReceiptType: "RETURN", Val: &val}.Decode()
+	assert.EqualError(t, err, "malformed RETURN receipt: missing id, pc, is")
+
+	_, err = Receipt{ReceiptType: "SCRIPT_RESULT", Result: &val}.Decode()
+	assert.EqualError(t, err, "malformed SCRIPT_RESULT receipt: missing gasUsed")
+
+	length := U64(3)
+	_, err = Receipt{
+		ReceiptType: "MESSAGE_OUT",
+		Sender:      &Address{},
+		Recipient:   &Address{},
+		Amount:      &val,
+		Nonce:       new(Nonce),
+		Len:         &length,
+		Digest:      &Bytes32{},
+		Data:        &HexString{Bytes: []byte{1}},
+	}.Decode()
+	assert.EqualError(t, err, "malformed MESSAGE_OUT receipt: data has 1 bytes but len is 3")
+
+	_, err = Receipt{ReceiptType: "UNKNOWN"}.Decode()
+	assert.EqualError(t, err, `unknown receipt type "UNKNOWN"`)
+
+	_, err = DecodeReceipts([]Receipt{{ReceiptType: "SCRIPT_RESULT", Result: &val, GasUsed: &val}, {ReceiptType: "LOG"}})
+	assert.EqualError(t, err, "decode receipt 1 failed: malformed LOG receipt: missing id, ra, rb, rc, rd, pc, is")
+}
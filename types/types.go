@@ -2635,3 +2635,94 @@ type QueryStateTransitionBytecodeByRootParams struct {
 	// SCHEMA: root HexString!
 	Root HexString `name:"root" kind:"SCALAR"`
 }
+
+// ====================
+// MutationArgumentObjects
+// --------------------
+
+type MutationStartSessionParams struct {
+}
+
+type MutationEndSessionParams struct {
+	// SCHEMA: id ID!
+	Id ID `name:"id" kind:"SCALAR"`
+}
+
+type MutationResetParams struct {
+	// SCHEMA: id ID!
+	Id ID `name:"id" kind:"SCALAR"`
+}
+
+type MutationExecuteParams struct {
+	// SCHEMA: id ID!
+	Id ID `name:"id" kind:"SCALAR"`
+	// SCHEMA: op String!
+	Op String `name:"op" kind:"SCALAR"`
+}
+
+type MutationSetSingleSteppingParams struct {
+	// SCHEMA: id ID!
+	Id ID `name:"id" kind:"SCALAR"`
+	// SCHEMA: enable Boolean!
+	Enable Boolean `name:"enable" kind:"SCALAR"`
+}
+
+type MutationSetBreakpointParams struct {
+	// SCHEMA: id ID!
+	Id ID `name:"id" kind:"SCALAR"`
+	// SCHEMA: breakpoint Breakpoint!
+	Breakpoint Breakpoint `name:"breakpoint" kind:"INPUT_OBJECT"`
+}
+
+type MutationStartTxParams struct {
+	// SCHEMA: id ID!
+	Id ID `name:"id" kind:"SCALAR"`
+	// SCHEMA: txJson String!
+	TxJson String `name:"txJson" kind:"SCALAR"`
+}
+
+type MutationContinueTxParams struct {
+	// SCHEMA: id ID!
+	Id ID `name:"id" kind:"SCALAR"`
+}
+
+type MutationDryRunParams struct {
+	// SCHEMA: txs [HexString!]!
+	Txs []HexString `name:"txs" kind:"SCALAR"`
+	// SCHEMA: utxoValidation Boolean
+	UtxoValidation *Boolean `name:"utxoValidation" kind:"SCALAR"`
+	// SCHEMA: gasPrice U64
+	GasPrice *U64 `name:"gasPrice" kind:"SCALAR"`
+}
+
+type MutationSubmitParams struct {
+	// SCHEMA: tx HexString!
+	Tx HexString `name:"tx" kind:"SCALAR"`
+}
+
+type MutationProduceBlocksParams struct {
+	// SCHEMA: startTimestamp Tai64Timestamp
+	StartTimestamp *Tai64Timestamp `name:"startTimestamp" kind:"SCALAR"`
+	// SCHEMA: blocksToProduce U32!
+	BlocksToProduce U32 `name:"blocksToProduce" kind:"SCALAR"`
+}
+
+// ====================
+// SubscriptionArgumentObjects
+// --------------------
+
+type SubscriptionStatusChangeParams struct {
+	// The ID of the transaction
+	// SCHEMA: id TransactionId!
+	Id TransactionId `name:"id" kind:"SCALAR"`
+}
+
+type SubscriptionSubmitAndAwaitParams struct {
+	// SCHEMA: tx HexString!
+	Tx HexString `name:"tx" kind:"SCALAR"`
+}
+
+type SubscriptionSubmitAndAwaitStatusParams struct {
+	// SCHEMA: tx HexString!
+	Tx HexString `name:"tx" kind:"SCALAR"`
+}
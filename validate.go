@@ -0,0 +1,115 @@
+package fuel
+
+import (
+	_ "embed"
+	"fmt"
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+//go:embed tools/schema.graphql
+var schemaContent string
+
+var loadSchema = sync.OnceValues(func() (*graphql.Schema, error) {
+	return graphql.ParseSchema(schemaContent, nil)
+})
+
+type QueryValidationErrors QueryErrors
+
+func (e QueryValidationErrors) Error() string {
+	return fmt.Sprintf("validate query failed: %s", QueryErrors(e).join())
+}
+
+// ValidateQuery checks the query text against the schema the types are generated from,
+// so drift between types.go and the node is found without sending the query.
+// It reports unknown fields, mismatched argument types, invalid union fragments and conflicting aliases.
+func ValidateQuery(query string) error {
+	schema, err := loadSchema()
+	if err != nil {
+		return fmt.Errorf("parse schema failed: %w", err)
+	}
+	var result QueryValidationErrors
+	for _, e := range schema.Validate(query) {
+		if isUnionMemberTypeClash(query, e) {
+			// The node does not require fields with the same name in different members of an union
+			// to have the same type, such as InputCoin.witnessIndex (Int!) and InputMessage.witnessIndex (U16!)
+			continue
+		}
+		qe := QueryError{Message: e.Message}
+		for _, loc := range e.Locations {
+			qe.Locations = append(qe.Locations, QueryErrorLocation{Line: loc.Line, Column: loc.Column})
+		}
+		result = append(result, qe)
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+var inlineFragmentPattern = regexp.MustCompile(`\.\.\.\s*on\s+(\w+)\s*$`)
+
+// isUnionMemberTypeClash returns true if the error only reports two fields with different types,
+// which are selected in inline fragments with different type conditions
+func isUnionMemberTypeClash(query string, e *gqlerrors.QueryError) bool {
+	if e.Rule != "OverlappingFieldsCanBeMerged" || len(e.Locations) != 2 ||
+		!strings.Contains(e.Message, " conflict because they return conflicting types ") {
+		return false
+	}
+	a, b := enclosingTypeCondition(query, e.Locations[0]), enclosingTypeCondition(query, e.Locations[1])
+	return a != "" && b != "" && a != b
+}
+
+// enclosingTypeCondition returns the type condition of the inline fragment directly containing the location,
+// empty if the location is not directly in an inline fragment
+func enclosingTypeCondition(query string, loc gqlerrors.Location) string {
+	lines := strings.SplitAfter(query, "\n")
+	if loc.Line < 1 || loc.Line > len(lines) {
+		return ""
+	}
+	offset := 0
+	for _, line := range lines[:loc.Line-1] {
+		offset += len(line)
+	}
+	// the column counts characters
+	column := 1
+	for i := range lines[loc.Line-1] {
+		if column == loc.Column {
+			offset += i
+			break
+		}
+		column++
+	}
+	var opened []int
+	for i := 0; i < offset; i++ {
+		switch query[i] {
+		case '"':
+			// skip the string, the generated queries have no block string
+			for i++; i < offset && query[i] != '"'; i++ {
+				if query[i] == '\\' {
+					i++
+				}
+			}
+		case '#':
+			for i < offset && query[i] != '\n' {
+				i++
+			}
+		case '{':
+			opened = append(opened, i)
+		case '}':
+			if len(opened) > 0 {
+				opened = opened[:len(opened)-1]
+			}
+		}
+	}
+	if len(opened) == 0 {
+		return ""
+	}
+	if m := inlineFragmentPattern.FindStringSubmatch(query[:opened[len(opened)-1]]); m != nil {
+		return m[1]
+	}
+	return ""
+}
@@ -0,0 +1,93 @@
+package fuel
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sentioxyz/fuel-go/types"
+	"github.com/sentioxyz/fuel-go/util"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_ValidateQuery(t *testing.T) {
+	assert.NoError(t, ValidateQuery(`{ block(height: "1067005") { id header { height time } } }`))
+	assert.EqualError(t,
+		ValidateQuery(`{ block(height: "1067005") { id header { height tim } } }`),
+		"validate query failed: (line:1,column:49): Cannot query field \"tim\" on type \"Header\". Did you mean \"time\"?",
+	)
+	assert.EqualError(t,
+		ValidateQuery(`{ blocks(first: "10") { nodes { id } } }`),
+		"validate query failed: (line:1,column:17): Argument \"first\" has invalid value \"10\".\nExpected type \"Int\", found \"10\".",
+	)
+	assert.EqualError(t,
+		ValidateQuery(`{ chain { latestBlock { consensus { ... on Coin { amount } } } } }`),
+		"validate query failed: (line:1,column:37): Fragment cannot be spread here as objects of type \"Consensus\" can never be of type \"Coin\".",
+	)
+}
+
+func Test_ValidateQueryOverlapping(t *testing.T) {
+	// same field with different types in different members of an union is accepted by the node
+	assert.NoError(t, ValidateQuery(`{
+  transaction(id: "0x01") {
+    inputs {
+      ... on InputCoin { witnessIndex }
+      ... on InputMessage { witnessIndex }
+    }
+  }
+}`))
+	assert.EqualError(t,
+		ValidateQuery(`{ b0: block(height: "1") { id } b0: block(height: "2") { id } }`),
+		"validate query failed: (line:1,column:3),(line:1,column:33): "+
+			"Fields \"b0\" conflict because they have differing arguments. "+
+			"Use different aliases on the fields to fetch both if this was intentional.",
+	)
+	assert.EqualError(t,
+		ValidateQuery(`{ b0: block(height: "1") { id } b0: chain { name } }`),
+		"validate query failed: (line:1,column:3),(line:1,column:33): "+
+			"Fields \"b0\" conflict because they return conflicting types Block and ChainInfo!. "+
+			"Use different aliases on the fields to fetch both if this was intentional.",
+	)
+}
+
+func Test_debugClient(t *testing.T) {
+	srv := newTestServer(t, func(string) any { return map[string]any{} })
+	cli := NewClient(srv.URL)
+	cli.SetDebug(true)
+
+	_, err := ExecuteQuery[map[string]any](context.Background(), cli, `{ chain { nam } }`)
+	assert.ErrorAs(t, err, &QueryValidationErrors{})
+
+	blockParams := []types.QueryBlockParams{
+		{Height: util.GetPointer[types.U32](1)},
+		{Id: &types.BlockId{Hash: common.HexToHash("0x01")}},
+	}
+	for mask := 0; mask < 1<<7; mask++ {
+		opt := GetBlockOption{
+			WithHeader:              mask&(1<<0) > 0,
+			WithConsensus:           mask&(1<<1) > 0,
+			WithTransactions:        mask&(1<<2) > 0,
+			WithTransactionDetail:   mask&(1<<3) > 0,
+			WithTransactionReceipts: mask&(1<<4) > 0,
+			WithContractBytecode:    mask&(1<<5) > 0,
+			WithContractSalt:        mask&(1<<6) > 0,
+		}
+		_, err = cli.GetBlock(context.Background(), blockParams[0], opt)
+		assert.NoError(t, err, "%+v", opt)
+		_, err = cli.GetBlocks(context.Background(), blockParams, opt)
+		assert.NoError(t, err, "%+v", opt)
+		_, err = cli.GetChain(context.Background(), GetChainOption{Simple: mask&1 > 0, GetBlockOption: opt})
+		assert.NoError(t, err, "%+v", opt)
+	}
+	for mask := 0; mask < 1<<4; mask++ {
+		opt := GetTransactionOption{
+			WithReceipts:         mask&(1<<0) > 0,
+			WithStatus:           mask&(1<<1) > 0,
+			WithContractBytecode: mask&(1<<2) > 0,
+			WithContractSalt:     mask&(1<<3) > 0,
+		}
+		_, err = cli.GetTransaction(context.Background(), types.QueryTransactionParams{
+			Id: types.TransactionId{Hash: common.HexToHash("0x01")},
+		}, opt)
+		assert.NoError(t, err, "%+v", opt)
+	}
+}